@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+var errNoShares = errors.New("no shares to combine")
+
+// AdditiveSplit returns n additive shares of the secret, i.e. n - 1 random scalars from crypto/rand and a last one such
+// that they sum to the secret, which AdditiveCombine recovers. All n shares are needed, as any fewer of them are
+// independent of the secret. It panics if the secret is nil or of another group, or if n is not positive.
+func (g Group) AdditiveSplit(secret *Scalar, n int) []*Scalar {
+	if secret == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	if secret.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	if n < 1 {
+		panic(errNonPositiveCount)
+	}
+
+	shares := make([]*Scalar, n)
+	last := secret.Copy()
+
+	for i := range n - 1 {
+		shares[i] = g.NewScalar().Random()
+		last.Subtract(shares[i])
+	}
+
+	shares[n-1] = last
+
+	return shares
+}
+
+// AdditiveCombine returns a new scalar set to the sum of the shares, e.g. the secret AdditiveSplit split them from. It
+// panics if there are no shares, if a share is nil, or if the shares are of different groups.
+func AdditiveCombine(shares []*Scalar) *Scalar {
+	if len(shares) == 0 {
+		panic(errNoShares)
+	}
+
+	for _, share := range shares {
+		if share == nil {
+			panic(internal.ErrParamNilScalar)
+		}
+	}
+
+	sum := shares[0].Copy()
+	for _, share := range shares[1:] {
+		sum.Add(share)
+	}
+
+	return sum
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	// The CBOR major types, in the three most significant bits of the initial byte of a data item (RFC 8949).
+	cborUnsigned = 0x00
+	cborBytes    = 0x40
+	cborArray    = 0x80
+
+	cborMajorMask = 0xe0
+	cborInfoMask  = 0x1f
+)
+
+var errCBOREncoding = errors.New("invalid CBOR encoding")
+
+// marshalCBOR returns the CBOR array holding the group identifier as an unsigned integer, and the encoding as a byte
+// string, with the deterministic encoding of RFC 8949.
+func marshalCBOR(g Group, encoding []byte) []byte {
+	out := make([]byte, 0, 6+len(encoding))
+	out = appendCBORHead(out, cborArray, 2)
+	out = appendCBORHead(out, cborUnsigned, uint64(g))
+	out = appendCBORHead(out, cborBytes, uint64(len(encoding)))
+
+	return append(out, encoding...)
+}
+
+// unmarshalCBOR returns the group identifier and the encoding held by data, as produced by marshalCBOR. Only the
+// deterministic encoding is accepted.
+func unmarshalCBOR(data []byte) (Group, []byte, error) {
+	n, data, err := readCBORHead(data, cborArray)
+	if err != nil || n != 2 {
+		return 0, nil, errCBOREncoding
+	}
+
+	id, data, err := readCBORHead(data, cborUnsigned)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	g := Group(id)
+	if uint64(g) != id || !g.Available() {
+		return 0, nil, internal.ErrInvalidGroup
+	}
+
+	n, data, err = readCBORHead(data, cborBytes)
+	if err != nil || n != uint64(len(data)) {
+		return 0, nil, errCBOREncoding
+	}
+
+	return g, data, nil
+}
+
+// appendCBORHead appends the head of a data item of the major type with argument n, which fits in 16 bits for all the
+// items used here.
+func appendCBORHead(out []byte, major byte, n uint64) []byte {
+	switch {
+	case n < 24:
+		return append(out, major|byte(n))
+	case n <= 0xff:
+		return append(out, major|24, byte(n))
+	default:
+		return append(out, major|25, byte(n>>8), byte(n))
+	}
+}
+
+// readCBORHead returns the argument of the head of the data item of the major type at the start of data, and the
+// remaining bytes. Arguments must be encoded in their shortest form, and fit in 16 bits.
+func readCBORHead(data []byte, major byte) (uint64, []byte, error) {
+	if len(data) == 0 || data[0]&cborMajorMask != major {
+		return 0, nil, errCBOREncoding
+	}
+
+	info := data[0] & cborInfoMask
+
+	switch {
+	case info < 24:
+		return uint64(info), data[1:], nil
+	case info == 24 && len(data) >= 2 && data[1] >= 24:
+		return uint64(data[1]), data[2:], nil
+	case info == 25 && len(data) >= 3 && data[1] != 0:
+		return uint64(data[1])<<8 | uint64(data[2]), data[3:], nil
+	default:
+		return 0, nil, errCBOREncoding
+	}
+}
+
+// MarshalCBOR returns the CBOR encoding of the element, an array holding the group identifier as an unsigned integer
+// and the encoding of the element as a byte string. It implements the cbor.Marshaler interface of
+// github.com/fxamacker/cbor.
+func (e *Element) MarshalCBOR() ([]byte, error) {
+	return marshalCBOR(e.Group(), e.Element.Encode()), nil
+}
+
+// UnmarshalCBOR sets the receiver to the decoding of the CBOR encoding produced by MarshalCBOR, and returns an error on
+// failure. A zero Element is set to an element of the encoded group, and an error is returned if the receiver already
+// belongs to another group. It implements the cbor.Unmarshaler interface of github.com/fxamacker/cbor.
+func (e *Element) UnmarshalCBOR(data []byte) error {
+	g, encoding, err := unmarshalCBOR(data)
+	if err != nil {
+		return fmt.Errorf("element UnmarshalCBOR: %w", err)
+	}
+
+	if err = e.decodeGroupEncoding(g, encoding); err != nil {
+		return fmt.Errorf("element UnmarshalCBOR: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalCBOR returns the CBOR encoding of the scalar, an array holding the group identifier as an unsigned integer
+// and the encoding of the scalar as a byte string. It implements the cbor.Marshaler interface of
+// github.com/fxamacker/cbor.
+func (s *Scalar) MarshalCBOR() ([]byte, error) {
+	return marshalCBOR(s.Group(), s.Scalar.Encode()), nil
+}
+
+// UnmarshalCBOR sets the receiver to the decoding of the CBOR encoding produced by MarshalCBOR, and returns an error on
+// failure. A zero Scalar is set to a scalar of the encoded group, and an error is returned if the receiver already
+// belongs to another group. It implements the cbor.Unmarshaler interface of github.com/fxamacker/cbor.
+func (s *Scalar) UnmarshalCBOR(data []byte) error {
+	g, encoding, err := unmarshalCBOR(data)
+	if err != nil {
+		return fmt.Errorf("scalar UnmarshalCBOR: %w", err)
+	}
+
+	if err = s.decodeGroupEncoding(g, encoding); err != nil {
+		return fmt.Errorf("scalar UnmarshalCBOR: %w", err)
+	}
+
+	return nil
+}
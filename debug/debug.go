@@ -18,6 +18,12 @@ var groupOrderPlusOne = map[ecc.Group][]byte{
 		238, 211, 245, 92, 26, 99, 18, 88, 214, 156, 247, 162, 222, 249, 222, 20,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
 	},
+	ecc.Decaf448Shake256: {
+		244, 68, 88, 171, 146, 194, 120, 35, 85, 143, 197, 141, 114, 194, 108, 33,
+		144, 54, 214, 174, 73, 219, 78, 196, 233, 35, 202, 124, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 63,
+	},
 	ecc.P256Sha256: {
 		255, 255, 255, 255, 0, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255,
 		188, 230, 250, 173, 167, 23, 158, 132, 243, 185, 202, 194, 252, 99, 37, 82,
@@ -41,6 +47,28 @@ var groupOrderPlusOne = map[ecc.Group][]byte{
 		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 254,
 		186, 174, 220, 230, 175, 72, 160, 59, 191, 210, 94, 140, 208, 54, 65, 66,
 	},
+	ecc.P224Sha256: {
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 22, 162,
+		224, 184, 240, 62, 19, 221, 41, 69, 92, 92, 42, 62,
+	},
+	ecc.Edwards448Shake256: {
+		244, 68, 88, 171, 146, 194, 120, 35, 85, 143, 197, 141, 114, 194, 108, 33,
+		144, 54, 214, 174, 73, 219, 78, 196, 233, 35, 202, 124, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 63, 0,
+	},
+	ecc.BLS12381G1Sha256: {
+		115, 237, 167, 83, 41, 157, 125, 72, 51, 57, 216, 8, 9, 161, 216, 5,
+		83, 189, 164, 2, 255, 254, 91, 254, 255, 255, 255, 255, 0, 0, 0, 2,
+	},
+	ecc.PallasSha256: {
+		2, 0, 0, 0, 33, 235, 70, 140, 221, 168, 148, 9, 252, 152, 70, 34,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+	},
+	ecc.VestaSha256: {
+		2, 0, 0, 0, 237, 48, 45, 153, 27, 249, 76, 9, 252, 152, 70, 34,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+	},
 }
 
 // BadScalarHigh returns an encoding of a Scalar above the group's order. Its decoding must return an error.
@@ -53,6 +81,12 @@ var fieldOrdersBE = map[ecc.Group][]byte{
 		127, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
 		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 237,
 	},
+	ecc.Decaf448Shake256: {
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 254, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255,
+	},
 	ecc.P256Sha256: {
 		2, 255, 255, 255, 255, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0,
 		0, 0, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
@@ -77,6 +111,29 @@ var fieldOrdersBE = map[ecc.Group][]byte{
 		2, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
 		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 254, 255, 255, 252, 47,
 	},
+	ecc.P224Sha256: {
+		2, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+	},
+	ecc.Edwards448Shake256: {
+		2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0,
+	},
+	ecc.BLS12381G1Sha256: {
+		128, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1,
+	},
+	ecc.PallasSha256: {
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	},
+	ecc.VestaSha256: {
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
+	},
 }
 
 // BadElementOffCurve returns an encoding of an Element that is not on the group's underlying curve.
@@ -90,6 +147,12 @@ var badElements = map[ecc.Group][]byte{
 		42, 41, 45, 247, 227, 44, 171, 171, 189, 157, 224, 136, 209, 209, 171, 236,
 		159, 192, 68, 15, 99, 126, 210, 251, 161, 69, 9, 77, 193, 75, 234, 8,
 	},
+	ecc.Decaf448Shake256: {
+		122, 84, 182, 104, 54, 193, 251, 221, 19, 210, 68, 29, 158, 20, 52, 220,
+		98, 202, 103, 127, 182, 143, 95, 230, 106, 70, 75, 170, 222, 205, 189, 0,
+		87, 111, 141, 107, 90, 195, 188, 200, 8, 68, 183, 213, 11, 28, 198, 96,
+		52, 68, 187, 231, 207, 207, 143, 192,
+	},
 	ecc.P256Sha256: {
 		180, 156, 135, 52, 186, 207, 78, 34, 25, 58, 107, 30, 29, 189, 67, 96,
 		27, 122, 38, 254, 237, 242, 41, 66, 32, 248, 155, 253, 65, 203, 45, 234, 107,
@@ -113,6 +176,29 @@ var badElements = map[ecc.Group][]byte{
 		248, 194, 40, 96, 152, 251, 181, 46, 76, 234, 70, 112, 83, 163, 182, 140,
 		48, 35, 199, 44, 130, 86, 124, 138, 93, 210, 45, 56, 95, 208, 36, 234, 104,
 	},
+	ecc.P224Sha256: {
+		3, 243, 104, 195, 21, 122, 252, 163, 176, 9, 24, 31, 75, 89, 31, 254,
+		105, 22, 79, 47, 150, 27, 241, 149, 168, 7, 240, 213, 87,
+	},
+	ecc.Edwards448Shake256: {
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 254, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 0,
+	},
+	ecc.BLS12381G1Sha256: {
+		154, 1, 17, 234, 57, 127, 230, 154, 75, 27, 167, 182, 67, 75, 172, 215,
+		100, 119, 75, 132, 243, 133, 18, 191, 103, 48, 210, 160, 246, 176, 246, 36,
+		30, 171, 255, 254, 177, 83, 255, 255, 185, 254, 255, 255, 255, 255, 170, 171,
+	},
+	ecc.PallasSha256: {
+		1, 0, 0, 0, 237, 48, 45, 153, 27, 249, 76, 9, 252, 152, 70, 34,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+	},
+	ecc.VestaSha256: {
+		1, 0, 0, 0, 33, 235, 70, 140, 221, 168, 148, 9, 252, 152, 70, 34,
+		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 64,
+	},
 }
 
 // BadElementEncoding returns a bad encoding of an element. Its decoding must return an error.
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// elementsCountLength is the byte length of the big-endian element count prefixing the encoding of EncodeElements.
+const elementsCountLength = 4
+
+// DecodeElements decodes each of the encodings in data into a new element of the group, as Element.Decode does. It
+// stops on the first invalid encoding and returns an error reporting its index.
+//
+// Decoding compressed encodings is dominated by the square root computation, which can't be batched, so each element
+// is decoded independently.
+func (g Group) DecodeElements(data [][]byte) ([]*Element, error) {
+	elements := make([]*Element, len(data))
+
+	for i, d := range data {
+		e := g.NewElement()
+		if err := e.Element.Decode(d); err != nil {
+			return nil, fmt.Errorf("DecodeElements: element %d: %w", i, elementDecodingError(err))
+		}
+
+		elements[i] = e
+	}
+
+	return elements, nil
+}
+
+// EncodeElements returns the element count as a 4-byte big-endian integer, followed by the concatenation of the
+// fixed-length encodings of the elements, which DecodeElementsBlob decodes. It panics if an element is nil or of
+// another group. Since the identity element is rejected when decoding, elements must not be the identity.
+func (g Group) EncodeElements(elements []*Element) []byte {
+	out := make([]byte, elementsCountLength, elementsCountLength+len(elements)*g.ElementLength())
+	binary.BigEndian.PutUint32(out, uint32(len(elements)))
+
+	for _, e := range elements {
+		if e == nil {
+			panic(internal.ErrParamNilPoint)
+		}
+
+		if e.Group() != g {
+			panic(internal.ErrCastElement)
+		}
+
+		out = append(out, e.Element.Encode()...)
+	}
+
+	return out
+}
+
+// DecodeElementsBlob decodes the output of EncodeElements into new elements of the group. It returns an error wrapping
+// ErrVectorLength if the length of data doesn't match the declared element count, and stops on the first invalid
+// encoding, returning an error reporting its index and matching ErrInvalidPointEncoding.
+func (g Group) DecodeElementsBlob(data []byte) ([]*Element, error) {
+	if len(data) < elementsCountLength {
+		return nil, fmt.Errorf("DecodeElementsBlob: %w", ErrVectorLength)
+	}
+
+	count := uint64(binary.BigEndian.Uint32(data))
+	length := g.ElementLength()
+	data = data[elementsCountLength:]
+
+	if uint64(len(data)) != count*uint64(length) {
+		return nil, fmt.Errorf("DecodeElementsBlob: %w", ErrVectorLength)
+	}
+
+	elements := make([]*Element, count)
+
+	for i := range elements {
+		e := g.NewElement()
+		if err := e.Element.Decode(data[i*length : (i+1)*length]); err != nil {
+			return nil, fmt.Errorf("DecodeElementsBlob: element %d: %w", i, elementDecodingError(err))
+		}
+
+		elements[i] = e
+	}
+
+	return elements, nil
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/der"
+)
+
+// ErrDERAlgorithm indicates that the algorithm or the curve of a DER encoded key doesn't match the group.
+var ErrDERAlgorithm = errors.New("DER key algorithm does not match the group")
+
+// derAlgorithm returns the algorithm identifying the group's keys in DER encodings, and false for the groups without
+// a standard identifier.
+func (g Group) derAlgorithm() (der.Algorithm, bool) {
+	switch g {
+	case P224Sha256:
+		return der.Algorithm{OID: der.OIDPublicKeyEC, NamedCurve: der.OIDNamedCurveP224}, true
+	case P256Sha256:
+		return der.Algorithm{OID: der.OIDPublicKeyEC, NamedCurve: der.OIDNamedCurveP256}, true
+	case P384Sha384:
+		return der.Algorithm{OID: der.OIDPublicKeyEC, NamedCurve: der.OIDNamedCurveP384}, true
+	case P521Sha512:
+		return der.Algorithm{OID: der.OIDPublicKeyEC, NamedCurve: der.OIDNamedCurveP521}, true
+	case Secp256k1Sha256:
+		return der.Algorithm{OID: der.OIDPublicKeyEC, NamedCurve: der.OIDNamedCurveSecp256k1}, true
+	case Edwards25519Sha512:
+		return der.Algorithm{OID: der.OIDPublicKeyEd25519}, true
+	case Edwards448Shake256:
+		return der.Algorithm{OID: der.OIDPublicKeyEd448}, true
+	default:
+		return der.Algorithm{}, false
+	}
+}
+
+// MarshalDER returns the DER encoding of the element as a public key in a SubjectPublicKeyInfo structure (RFC 5280).
+// For the NIST groups and Secp256k1, the algorithm is id-ecPublicKey with the curve's named curve OID (RFC 5480), and
+// the key is the uncompressed SEC1 encoding of the element. For Edwards25519 and Edwards448, the algorithm is
+// id-Ed25519 or id-Ed448 (RFC 8410), and the key is the element's encoding, which is that of RFC 8032 public keys.
+//
+// It returns an error for the identity, and an error wrapping errors.ErrUnsupported for Ristretto255, Decaf448,
+// BLS12-381 G1, Pallas, and Vesta, which have no standard algorithm identifier.
+func (e *Element) MarshalDER() ([]byte, error) {
+	g := e.Group()
+
+	algorithm, ok := g.derAlgorithm()
+	if !ok {
+		return nil, fmt.Errorf("element MarshalDER: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	if e.IsIdentity() {
+		return nil, fmt.Errorf("element MarshalDER: %w", internal.ErrIdentity)
+	}
+
+	key := e.Encode()
+	if g.hasSEC1() {
+		key, _ = e.EncodeUncompressed()
+	}
+
+	return der.MarshalPublicKey(algorithm, key)
+}
+
+// DecodeElementDER returns the element of the group held by the DER encoded SubjectPublicKeyInfo structure, as
+// produced by Element.MarshalDER, and an error on failure. SEC1 keys can be compressed or uncompressed, and are
+// otherwise decoded as Element.Decode does.
+//
+// It returns an error wrapping ErrDERAlgorithm if the algorithm or the curve of the key don't match the group, and an
+// error wrapping errors.ErrUnsupported for the groups MarshalDER doesn't support.
+func DecodeElementDER(g Group, data []byte) (*Element, error) {
+	e, err := g.decodeElementDER(data)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeElementDER: %w", err)
+	}
+
+	return e, nil
+}
+
+func (g Group) decodeElementDER(data []byte) (*Element, error) {
+	algorithm, ok := g.derAlgorithm()
+	if !ok {
+		return nil, fmt.Errorf("%w for %s", errors.ErrUnsupported, g)
+	}
+
+	a, key, err := der.ParsePublicKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !a.Equal(algorithm) {
+		return nil, ErrDERAlgorithm
+	}
+
+	e := g.NewElement()
+	if len(key) != 0 && key[0] == 0x04 && g.hasSEC1() {
+		err = e.DecodeUncompressed(key)
+	} else {
+		err = e.Decode(key)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// MarshalDER returns the DER encoding of the scalar as an elliptic curve private key in a PKCS #8 PrivateKeyInfo
+// structure (RFC 5208), for the NIST groups and Secp256k1. The algorithm is the same as for Element.MarshalDER, and
+// the key is an ECPrivateKey structure (RFC 5915) holding the big-endian scalar and the corresponding public key.
+//
+// It returns an error for the zero scalar, and an error wrapping errors.ErrUnsupported for the other groups. In
+// particular, the RFC 8410 private keys of Edwards25519 and Edwards448 are seeds the scalar is derived from, and can't
+// be recovered from it.
+func (s *Scalar) MarshalDER() ([]byte, error) {
+	g := s.Group()
+	if !g.hasSEC1() {
+		return nil, fmt.Errorf("scalar MarshalDER: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	if s.IsZero() {
+		return nil, fmt.Errorf("scalar MarshalDER: %w", internal.ErrParamNilScalar)
+	}
+
+	algorithm, _ := g.derAlgorithm()
+	publicKey, _ := g.Base().Multiply(s).EncodeUncompressed()
+
+	return der.MarshalPrivateKey(algorithm, s.Encode(), publicKey)
+}
+
+// DecodeScalarDER returns the scalar of the group held by the DER encoded PKCS #8 PrivateKeyInfo structure, as
+// produced by Scalar.MarshalDER, and an error on failure. The public key in the structure, if any, is ignored.
+//
+// It returns an error wrapping ErrDERAlgorithm if the algorithm or the curve of the key don't match the group, and an
+// error wrapping errors.ErrUnsupported for the groups Scalar.MarshalDER doesn't support.
+func DecodeScalarDER(g Group, data []byte) (*Scalar, error) {
+	s, err := g.decodeScalarDER(data, der.ParsePrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeScalarDER: %w", err)
+	}
+
+	return s, nil
+}
+
+func (g Group) decodeScalarDER(data []byte, parse func([]byte) (der.Algorithm, []byte, error)) (*Scalar, error) {
+	if !g.hasSEC1() {
+		return nil, fmt.Errorf("%w for %s", errors.ErrUnsupported, g)
+	}
+
+	a, key, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if algorithm, _ := g.derAlgorithm(); !a.Equal(algorithm) {
+		return nil, ErrDERAlgorithm
+	}
+
+	// As other implementations, accept keys whose leading zeros have been stripped, which SEC1 doesn't allow.
+	length := g.ScalarLength()
+	if len(key) > length {
+		return nil, internal.ErrParamScalarLength
+	}
+
+	padded := make([]byte, length)
+	copy(padded[length-len(key):], key)
+
+	s := g.NewScalar()
+	if err = s.Decode(padded); err != nil {
+		return nil, err
+	}
+
+	if s.IsZero() {
+		return nil, internal.ErrParamNilScalar
+	}
+
+	return s, nil
+}
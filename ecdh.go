@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"crypto/ecdh"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/edwards25519"
+)
+
+var errX25519Scalar = errors.New("scalar has no clamped X25519 private key representation")
+
+// ECDH returns the Diffie-Hellman shared element of the secret key and the peer's public key, i.e. their product, which
+// callers should hash to derive keys, e.g. with DeriveSharedKey. The peer's public key is validated first, to prevent
+// invalid-curve and small-subgroup attacks: an error is returned if it is nil, the identity, of another group, or not
+// in the prime-order subgroup (see Element.IsValid). An error is returned too if the secret key is nil, zero, or of
+// another group.
+//
+// In the groups with a cofactor, i.e. Edwards25519, Edwards448, and BLS12-381 G1, the product is additionally
+// multiplied by the cofactor (cofactor Diffie-Hellman), as is customary with these curves.
+func ECDH(sk *Scalar, peerPublic *Element) (*Element, error) {
+	if sk == nil || sk.IsZero() {
+		return nil, fmt.Errorf("ECDH: %w", internal.ErrParamNilScalar)
+	}
+
+	if peerPublic == nil {
+		return nil, fmt.Errorf("ECDH: %w", internal.ErrParamNilPoint)
+	}
+
+	if peerPublic.Group() != sk.Group() {
+		return nil, fmt.Errorf("ECDH: %w", internal.ErrCastElement)
+	}
+
+	if peerPublic.IsIdentity() {
+		return nil, fmt.Errorf("ECDH: %w", internal.ErrIdentity)
+	}
+
+	if !peerPublic.IsValid() {
+		return nil, fmt.Errorf("ECDH: %w", internal.ErrPointNotInSubgroup)
+	}
+
+	return peerPublic.Copy().Multiply(sk).ClearCofactor(), nil
+}
+
+// ecdhCurve returns the crypto/ecdh curve of the group, and nil for the groups crypto/ecdh doesn't support.
+func (g Group) ecdhCurve() ecdh.Curve {
+	switch g {
+	case P256Sha256:
+		return ecdh.P256()
+	case P384Sha384:
+		return ecdh.P384()
+	case P521Sha512:
+		return ecdh.P521()
+	case Edwards25519Sha512:
+		return ecdh.X25519()
+	default:
+		return nil
+	}
+}
+
+// ecdhGroup returns the group of the crypto/ecdh curve.
+func ecdhGroup(curve ecdh.Curve) (Group, error) {
+	for _, g := range []Group{P256Sha256, P384Sha384, P521Sha512, Edwards25519Sha512} {
+		if g.ecdhCurve() == curve {
+			return g, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w for curve %v", errors.ErrUnsupported, curve)
+}
+
+// ToECDH returns the crypto/ecdh public key of the element, for P256Sha256, P384Sha384, and P521Sha512, and for
+// Edwards25519Sha512 as an X25519 public key holding its Montgomery u coordinate. The latter is only meaningful for
+// elements of the prime-order subgroup, as X25519 clears the cofactor. It returns an error for the identity, and an
+// error wrapping errors.ErrUnsupported for the other groups.
+func (e *Element) ToECDH() (*ecdh.PublicKey, error) {
+	g := e.Group()
+
+	curve := g.ecdhCurve()
+	if curve == nil {
+		return nil, fmt.Errorf("element ToECDH: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	if e.IsIdentity() {
+		return nil, fmt.Errorf("element ToECDH: %w", internal.ErrIdentity)
+	}
+
+	key := e.Element.XCoordinate()
+	if g != Edwards25519Sha512 {
+		key, _ = e.EncodeUncompressed()
+	}
+
+	pub, err := curve.NewPublicKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("element ToECDH: %w", err)
+	}
+
+	return pub, nil
+}
+
+// ElementFromECDH returns the element of the crypto/ecdh public key, in P256Sha256, P384Sha384, or P521Sha512, or in
+// Edwards25519Sha512 for X25519 keys. An X25519 public key only holds a Montgomery u coordinate, which matches two
+// opposite points: the one with a non-negative x coordinate is returned, which yields the same X25519 shared secrets.
+// The element is validated as Element.Decode does, and an error wrapping errors.ErrUnsupported is returned for other
+// curves.
+func ElementFromECDH(pub *ecdh.PublicKey) (*Element, error) {
+	g, err := ecdhGroup(pub.Curve())
+	if err != nil {
+		return nil, fmt.Errorf("ElementFromECDH: %w", err)
+	}
+
+	e := g.NewElement()
+
+	if g == Edwards25519Sha512 {
+		var encoded []byte
+
+		encoded, err = edwards25519.EncodeFromMontgomery(pub.Bytes())
+		if err == nil {
+			err = e.Element.Decode(encoded)
+		}
+	} else {
+		err = e.DecodeUncompressed(pub.Bytes())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("ElementFromECDH: %w", err)
+	}
+
+	return e, nil
+}
+
+// ToECDHPrivate returns the crypto/ecdh private key of the scalar, for P256Sha256, P384Sha384, and P521Sha512, and for
+// Edwards25519Sha512 as an X25519 private key. X25519 clamps its private keys to multiples of 8 in [2^254, 2^255), so
+// the returned key is the one whose clamped value is congruent to the scalar modulo the group order, which only exists
+// for about half of the scalars: an error is returned for the others. In both cases the keys yield the same shared
+// secrets as the scalar with elements of the prime-order subgroup.
+//
+// It returns an error for the zero scalar, and an error wrapping errors.ErrUnsupported for the other groups.
+func (s *Scalar) ToECDHPrivate() (*ecdh.PrivateKey, error) {
+	g := s.Group()
+
+	curve := g.ecdhCurve()
+	if curve == nil {
+		return nil, fmt.Errorf("scalar ToECDHPrivate: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	if s.IsZero() {
+		return nil, fmt.Errorf("scalar ToECDHPrivate: %w", internal.ErrParamNilScalar)
+	}
+
+	key := s.Encode()
+
+	if g == Edwards25519Sha512 {
+		// Find k = 8 * m with m = s / 8 mod order, and 2^251 <= m < 2^252.
+		order := g.OrderBigInt()
+		m := new(big.Int).SetBytes(internal.Reverse(key))
+		m.Mul(m, new(big.Int).ModInverse(big.NewInt(8), order)).Mod(m, order)
+
+		if m.BitLen() != 252 {
+			return nil, fmt.Errorf("scalar ToECDHPrivate: %w", errX25519Scalar)
+		}
+
+		key = internal.Reverse(m.Lsh(m, 3).FillBytes(make([]byte, g.ScalarLength())))
+	}
+
+	priv, err := curve.NewPrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("scalar ToECDHPrivate: %w", err)
+	}
+
+	return priv, nil
+}
+
+// ScalarFromECDH returns the scalar of the crypto/ecdh private key, in P256Sha256, P384Sha384, or P521Sha512, or in
+// Edwards25519Sha512 for X25519 keys, in which case it's the clamped key reduced modulo the group order. It returns an
+// error wrapping errors.ErrUnsupported for other curves.
+func ScalarFromECDH(priv *ecdh.PrivateKey) (*Scalar, error) {
+	g, err := ecdhGroup(priv.Curve())
+	if err != nil {
+		return nil, fmt.Errorf("ScalarFromECDH: %w", err)
+	}
+
+	key := priv.Bytes()
+	s := g.NewScalar()
+
+	if g == Edwards25519Sha512 {
+		key[0] &= 248
+		key[31] &= 127
+		key[31] |= 64
+		s.SetBytesReduced(internal.Reverse(key))
+
+		return s, nil
+	}
+
+	if err = s.Decode(key); err != nil {
+		return nil, fmt.Errorf("ScalarFromECDH: %w", err)
+	}
+
+	return s, nil
+}
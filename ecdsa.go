@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// ellipticCurve returns the crypto/elliptic curve of the group, and nil for the groups crypto/elliptic doesn't support.
+func (g Group) ellipticCurve() elliptic.Curve {
+	switch g {
+	case P224Sha256:
+		return elliptic.P224()
+	case P256Sha256:
+		return elliptic.P256()
+	case P384Sha384:
+		return elliptic.P384()
+	case P521Sha512:
+		return elliptic.P521()
+	default:
+		return nil
+	}
+}
+
+// ToECDSAPublicKey returns the crypto/ecdsa public key of the element, for the NIST groups. It returns an error for the
+// identity, and an error wrapping errors.ErrUnsupported for the other groups.
+func (e *Element) ToECDSAPublicKey() (*ecdsa.PublicKey, error) {
+	g := e.Group()
+
+	curve := g.ellipticCurve()
+	if curve == nil {
+		return nil, fmt.Errorf("element ToECDSAPublicKey: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	x, y, err := e.affineCoordinates()
+	if err != nil {
+		return nil, fmt.Errorf("element ToECDSAPublicKey: %w", err)
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: curve,
+		X:     new(big.Int).SetBytes(x),
+		Y:     new(big.Int).SetBytes(y),
+	}, nil
+}
+
+// ElementFromECDSAPublicKey returns the element of the crypto/ecdsa public key, in the NIST group of its curve. It
+// returns an error wrapping ErrPointNotOnCurve if the coordinates are not those of a point of the curve, and an error
+// wrapping errors.ErrUnsupported for the other curves.
+func ElementFromECDSAPublicKey(pub *ecdsa.PublicKey) (*Element, error) {
+	if pub == nil || pub.X == nil || pub.Y == nil {
+		return nil, fmt.Errorf("ElementFromECDSAPublicKey: %w", internal.ErrParamNilPoint)
+	}
+
+	for _, g := range []Group{P224Sha256, P256Sha256, P384Sha384, P521Sha512} {
+		if g.ellipticCurve() != pub.Curve {
+			continue
+		}
+
+		length := g.ElementLength() - 1
+		if pub.X.Sign() < 0 || pub.Y.Sign() < 0 || pub.X.BitLen() > 8*length || pub.Y.BitLen() > 8*length {
+			return nil, fmt.Errorf("ElementFromECDSAPublicKey: %w", ErrPointNotOnCurve)
+		}
+
+		e := g.NewElement()
+		if err := e.SetCoordinates(pub.X.FillBytes(make([]byte, length)), pub.Y.FillBytes(make([]byte, length))); err != nil {
+			return nil, fmt.Errorf("ElementFromECDSAPublicKey: %w", err)
+		}
+
+		return e, nil
+	}
+
+	return nil, fmt.Errorf("ElementFromECDSAPublicKey: %w for curve %v", errors.ErrUnsupported, pub.Curve)
+}
@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package ecdsa implements ECDSA signatures (FIPS 186-5) over the ecc groups of short Weierstrass curves with SEC1
+// encodings, i.e. P224Sha256, P256Sha256, P384Sha384, P521Sha512, and Secp256k1Sha256, with deterministic nonces as
+// specified in RFC 6979. Messages are hashed with the group's hash function (see ecc.Group.HashFunc). Recover recovers
+// the signer's public key from a signature and its recovery id, as in Ethereum.
+package ecdsa
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/rfc6979"
+)
+
+var errUnsupportedGroup = errors.New("ECDSA is not supported for this group")
+
+// Option configures Sign and Verify.
+type Option func(*config)
+
+type config struct {
+	lowS bool
+}
+
+// WithLowS makes Sign return the low-s form of signatures, with s at most half the group order, and Verify reject the
+// others. Both forms are otherwise valid, and either can be computed from the other: requiring low-s signatures, as
+// Bitcoin and Ethereum do on secp256k1, makes them non-malleable.
+func WithLowS() Option {
+	return func(c *config) {
+		c.lowS = true
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// supported returns whether ECDSA is supported for the group.
+func supported(g ecc.Group) bool {
+	switch g {
+	case ecc.P224Sha256, ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512, ecc.Secp256k1Sha256:
+		return true
+	default:
+		return false
+	}
+}
+
+// Sign returns the ECDSA signature (r, s) of the message with the secret key sk, using the deterministic nonce of
+// RFC 6979. It panics if the group is not supported, or if sk is nil, zero, or of another group.
+func Sign(g ecc.Group, sk *ecc.Scalar, msg []byte, opts ...Option) (r, s *ecc.Scalar) {
+	if !supported(g) {
+		panic(errUnsupportedGroup)
+	}
+
+	if sk == nil || sk.IsZero() {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	if sk.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	c := newConfig(opts)
+	digest := hashMessage(g, msg)
+	e := bits2int(g, digest)
+	order := g.OrderBigInt()
+	nonces := rfc6979.New(g.HashFunc().New, order, sk.Encode(), rfc6979.Bits2Octets(order, digest), nil)
+
+	for {
+		k := g.NewScalar().SetBytesReduced(nonces.Next().Bytes())
+
+		r = xCoordinate(g, g.Base().Multiply(k))
+		if r.IsZero() {
+			continue
+		}
+
+		// s = (e + r * sk) / k
+		s = r.Copy().MultiplyAdd(sk, e).Multiply(k.Invert())
+		if s.IsZero() {
+			continue
+		}
+
+		if c.lowS && isHighS(g, s) {
+			s = g.NewScalar().Subtract(s)
+		}
+
+		return r, s
+	}
+}
+
+// Verify returns whether (r, s) is a valid ECDSA signature of the message for the public key pk. It returns false if
+// the group is not supported, for a nil or identity public key, or if an input is of another group. It runs in
+// variable time.
+func Verify(g ecc.Group, pk *ecc.Element, msg []byte, r, s *ecc.Scalar, opts ...Option) bool {
+	if !supported(g) {
+		return false
+	}
+
+	if pk == nil || r == nil || s == nil || pk.Group() != g || r.Group() != g || s.Group() != g || pk.IsIdentity() ||
+		r.IsZero() || s.IsZero() {
+		return false
+	}
+
+	if newConfig(opts).lowS && isHighS(g, s) {
+		return false
+	}
+
+	// R = (e / s) * G + (r / s) * pk
+	w := s.Copy().Invert()
+	e := bits2int(g, hashMessage(g, msg))
+	p := g.DoubleScalarBaseMultBase(e.Multiply(w), r.Copy().Multiply(w), pk)
+
+	if p.IsIdentity() {
+		return false
+	}
+
+	return xCoordinate(g, p).Equal(r)
+}
+
+// xCoordinate returns the affine x coordinate of the non-identity element reduced modulo the group order.
+func xCoordinate(g ecc.Group, e *ecc.Element) *ecc.Scalar {
+	x, _, err := e.AffineCoordinates()
+	if err != nil {
+		panic(err)
+	}
+
+	return g.NewScalar().SetBytesReduced(x)
+}
+
+// isHighS returns whether s is larger than half the group order.
+func isHighS(g ecc.Group, s *ecc.Scalar) bool {
+	half := new(big.Int).Rsh(g.OrderBigInt(), 1)
+	return new(big.Int).SetBytes(s.Encode()).Cmp(half) > 0
+}
+
+func hashMessage(g ecc.Group, msg []byte) []byte {
+	h := g.HashFunc().New()
+	_, _ = h.Write(msg)
+
+	return h.Sum(nil)
+}
+
+// bits2int returns the scalar of the leftmost bits of the digest, as many as the bit length of the group order
+// (RFC 6979 section 2.3.2), reduced modulo the group order as ECDSA does.
+func bits2int(g ecc.Group, digest []byte) *ecc.Scalar {
+	return g.NewScalar().SetBytesReduced(rfc6979.Bits2Int(g.OrderBigInt(), digest).Bytes())
+}
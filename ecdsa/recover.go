@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecdsa
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc"
+)
+
+// ErrInvalidSignature is returned by Recover when no public key can be recovered from the signature.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Recover returns the public key of the signer of the (r, s) signature of the message digest, as used in Ethereum over
+// Secp256k1Sha256. The digest is the hash of the message, e.g. with the group's hash function as Sign computes it, or
+// Keccak-256 for Ethereum.
+//
+// The recovery id identifies the commitment R = k * G of the signature among the candidates whose x coordinate reduces
+// to r: its first bit is the parity of the y coordinate of R, and its second bit is set in the rare case where that x
+// coordinate is r + n, for the group order n, rather than r.
+//
+// An ErrInvalidSignature error is returned if the recovery id is larger than 3, if r or s is nil, zero, or of another
+// group, or if the recovery id designates no point. With WithLowS, high-s signatures are rejected too. It runs in
+// variable time.
+func Recover(g ecc.Group, digest []byte, r, s *ecc.Scalar, recID byte, opts ...Option) (*ecc.Element, error) {
+	if !supported(g) {
+		return nil, fmt.Errorf("Recover: %w", errUnsupportedGroup)
+	}
+
+	if recID > 3 || r == nil || s == nil || r.Group() != g || s.Group() != g || r.IsZero() || s.IsZero() {
+		return nil, fmt.Errorf("Recover: %w", ErrInvalidSignature)
+	}
+
+	if newConfig(opts).lowS && isHighS(g, s) {
+		return nil, fmt.Errorf("Recover: %w", ErrInvalidSignature)
+	}
+
+	x := new(big.Int).SetBytes(r.Encode())
+	if recID&2 != 0 {
+		x.Add(x, g.OrderBigInt())
+	}
+
+	if x.Cmp(new(big.Int).SetBytes(g.FieldPrime())) >= 0 {
+		return nil, fmt.Errorf("Recover: %w", ErrInvalidSignature)
+	}
+
+	// R is decoded from its compressed SEC1 encoding, which fails if x is not the coordinate of a point.
+	encoded := make([]byte, g.ElementLength())
+	encoded[0] = 2 | recID&1
+	x.FillBytes(encoded[1:])
+
+	commitment := g.NewElement()
+	if err := commitment.Decode(encoded); err != nil {
+		return nil, fmt.Errorf("Recover: %w", ErrInvalidSignature)
+	}
+
+	// pk = (s * R - e * G) / r
+	rInv := r.Copy().Invert()
+	e := bits2int(g, digest)
+	pk := g.DoubleScalarBaseMultBase(g.NewScalar().Subtract(e.Multiply(rInv)), s.Copy().Multiply(rInv), commitment)
+
+	if pk.IsIdentity() {
+		return nil, fmt.Errorf("Recover: %w", ErrInvalidSignature)
+	}
+
+	return pk, nil
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// ToEd25519PublicKey returns the crypto/ed25519 public key of the element, i.e. its 32-byte encoding, for
+// Edwards25519Sha512. It returns nil for the identity and for the other groups.
+//
+// Note that crypto/ed25519 verifies signatures with the cofactorless equation, so public keys with a small-order
+// component may not behave as their prime-order subgroup counterparts: only use elements for which IsValid is true.
+func (e *Element) ToEd25519PublicKey() ed25519.PublicKey {
+	if e.Group() != Edwards25519Sha512 || e.IsIdentity() {
+		return nil
+	}
+
+	return e.Element.Encode()
+}
+
+// Ed25519PublicKeyToElement returns the Edwards25519Sha512 element of the crypto/ed25519 public key, and an error if it
+// is not a valid 32-byte encoding of a non-identity point. As for Element.Decode, small-order and mixed-order points are
+// accepted, since crypto/ed25519 accepts them too: use IsValid to reject them where a prime-order subgroup element is
+// required.
+func Ed25519PublicKeyToElement(pk ed25519.PublicKey) (*Element, error) {
+	if len(pk) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("Ed25519PublicKeyToElement: %w", internal.ErrDecodingInvalidLength)
+	}
+
+	e := Edwards25519Sha512.NewElement()
+	if err := e.Element.Decode(pk); err != nil {
+		return nil, fmt.Errorf("Ed25519PublicKeyToElement: %w", err)
+	}
+
+	return e, nil
+}
+
+// VerifyEd25519Batch returns whether all the crypto/ed25519 signatures of the messages are valid for their respective
+// public keys, verifying them at once with a random linear combination and a single multi-scalar multiplication, i.e.
+// checking 8 * (sum(a_i * S_i) * B - sum(a_i * R_i) - sum(a_i * k_i * A_i)) = 0 for random weights a_i. It runs in
+// variable time, and returns false if the slices are empty or have different lengths.
+//
+// As in ZIP 215, the check is cofactored, whereas ed25519.Verify is not: both agree on the signatures of honest signers,
+// but they may disagree on crafted signatures with small-order components, for which only the batch accepts. Signatures
+// with an identity commitment R are rejected. If it returns false, ed25519.Verify tells which signatures are invalid.
+func VerifyEd25519Batch(pubs []ed25519.PublicKey, messages, signatures [][]byte) bool {
+	n := len(pubs)
+	if n == 0 || len(messages) != n || len(signatures) != n {
+		return false
+	}
+
+	g := Edwards25519Sha512
+	scalars := make([]*Scalar, 0, 2*n+1)
+	elements := make([]*Element, 0, 2*n+1)
+	sum := g.NewScalar()
+
+	for i, pk := range pubs {
+		pub, err := Ed25519PublicKeyToElement(pk)
+		if err != nil || len(signatures[i]) != ed25519.SignatureSize {
+			return false
+		}
+
+		r := g.NewElement()
+		if err = r.Decode(signatures[i][:32]); err != nil {
+			return false
+		}
+
+		z := g.NewScalar()
+		if err = z.Decode(signatures[i][32:]); err != nil {
+			return false
+		}
+
+		// k = SHA-512(R || A || M), as a little-endian integer reduced modulo the order.
+		h := sha512.New()
+		h.Write(signatures[i][:32])
+		h.Write(pk)
+		h.Write(messages[i])
+		a := g.NewScalar().Random()
+		k := g.NewScalar().SetBytesReduced(internal.Reverse(h.Sum(nil)))
+		sum.Add(a.Copy().Multiply(z))
+
+		scalars = append(scalars, g.NewScalar().Subtract(k.Multiply(a)), g.NewScalar().Subtract(a))
+		elements = append(elements, pub, r)
+	}
+
+	scalars = append(scalars, sum)
+	elements = append(elements, g.Base())
+
+	return g.MultiScalarMult(scalars, elements).ClearCofactor().IsIdentity()
+}
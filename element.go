@@ -9,12 +9,21 @@
 package ecc
 
 import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
 	"strings"
 
 	"github.com/0xBridge/ecc/internal"
 )
 
+// ErrPointNotOnCurve indicates that affine coordinates don't define a point of the group's curve.
+var ErrPointNotOnCurve = internal.ErrPointNotOnCurve
+
 // Element represents an element on the curve of the prime-order group.
 type Element struct {
 	_ disallowEqual
@@ -40,6 +49,20 @@ func (e *Element) Identity() *Element {
 	return &Element{Element: e.Element.Identity()}
 }
 
+// RandomFromReader sets the element to the product of the base point and a scalar drawn from r with
+// Scalar.RandomFromReader, and returns it. The element is thus never the identity, and is reproducible from the
+// reader's output, as RandomElement is from crypto/rand. An error is returned as for Scalar.RandomFromReader.
+func (e *Element) RandomFromReader(r io.Reader) (*Element, error) {
+	s, err := e.Group().NewScalar().RandomFromReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Element.Set(e.Group().ScalarBaseMult(s).Element)
+
+	return e, nil
+}
+
 // Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
 func (e *Element) Add(element *Element) *Element {
 	if element == nil {
@@ -51,6 +74,27 @@ func (e *Element) Add(element *Element) *Element {
 	return e
 }
 
+// AddInto sets the receiver to a + b, and returns the receiver, without modifying a or b unless one of them is the
+// receiver. It spares the copy of Set(a).Add(b) when b is the receiver. It panics if a or b is nil or does not belong
+// to the receiver's group.
+func (e *Element) AddInto(a, b *Element) *Element {
+	checkElements(a, b)
+
+	if a.Group() != e.Group() {
+		panic(internal.ErrCastElement)
+	}
+
+	if e == b {
+		e.Element.Add(a.Element)
+		return e
+	}
+
+	e.Element.Set(a.Element)
+	e.Element.Add(b.Element)
+
+	return e
+}
+
 // Double sets the receiver to its double, and returns it.
 func (e *Element) Double() *Element {
 	e.Element.Double()
@@ -74,18 +118,80 @@ func (e *Element) Subtract(element *Element) *Element {
 	return e
 }
 
-// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
+// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it. If the
+// receiver is the group's base point, the faster ScalarBaseMult is used.
 func (e *Element) Multiply(scalar *Scalar) *Element {
 	if scalar == nil {
 		e.Element.Identity()
 		return e
 	}
 
+	if g := e.Group(); e.isBase(g) {
+		e.Element.Set(g.ScalarBaseMult(scalar).Element)
+		return e
+	}
+
 	e.Element.Multiply(scalar.Scalar)
 
 	return e
 }
 
+// MultiplyBig sets the receiver to k * receiver, and returns the receiver. k is reduced modulo the group order, a
+// negative k amounting to the multiplication of the negated receiver by -k, so that it is the same as
+// Multiply(NewScalar().SetBigInt(k)). A nil k sets the receiver to the identity, like a nil scalar does in Multiply.
+func (e *Element) MultiplyBig(k *big.Int) *Element {
+	if k == nil {
+		e.Element.Identity()
+		return e
+	}
+
+	return e.Multiply(e.Group().NewScalar().SetBigInt(k))
+}
+
+// isBase returns whether the element is the base point of the group, comparing it to the base point cached at the
+// group's initialization rather than building a new one on every call. The NIST backends detect it in their own
+// multiplication, so it returns false for them, sparing a comparison that costs an inversion.
+func (e *Element) isBase(g Group) bool {
+	switch g {
+	case P224Sha256, P256Sha256, P384Sha384, P521Sha512:
+		return false
+	default:
+		return e.Element.Equal(g.base()) == 1
+	}
+}
+
+// MultiplyVartime sets the receiver to the product of the input scalar and the receiver, and returns the receiver, like
+// Multiply, but using a faster algorithm whose execution time depends on the scalar. It must therefore only be used with
+// public scalars, e.g. when verifying signatures, and never with secret keys or nonces. A nil scalar sets the receiver
+// to the identity.
+func (e *Element) MultiplyVartime(scalar *Scalar) *Element {
+	if scalar == nil {
+		e.Element.Identity()
+		return e
+	}
+
+	g := e.Group()
+	if scalar.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	var p internal.Element
+
+	switch m, ok := g.get().(internal.VarTimeMultiScalarMultiplier); {
+	case ok:
+		p = m.VarTimeMultiScalarMult([]internal.Scalar{scalar.Scalar}, []internal.Element{e.Element})
+	case g == P256Sha256:
+		// The constant-time P-256 multiplication is assembly-optimized on common platforms, and faster than wNAF.
+		return e.Multiply(scalar)
+	default:
+		p = g.wnafMult([][]byte{g.littleEndianScalar(scalar.Scalar)}, []internal.Element{e.Element}).Element
+	}
+
+	e.Element.Set(p)
+
+	return e
+}
+
 // Equal returns true if the elements are equivalent, and false otherwise.
 func (e *Element) Equal(element *Element) bool {
 	if element == nil {
@@ -95,11 +201,72 @@ func (e *Element) Equal(element *Element) bool {
 	return e.Element.Equal(element.Element) == 1
 }
 
+// ClearCofactor sets the receiver to its product with the group's cofactor, and returns it. The result is always in
+// the prime-order subgroup: small-order points become the identity, and mixed-order points lose their small-order
+// component. Note that this changes the value of any point of the prime-order subgroup too (it is multiplied by the
+// cofactor), so it is meant to map untrusted points to the subgroup, not as a validity check (see IsValid). The cofactor
+// is 8 for Edwards25519, 4 for Edwards448, 0x396c8c005555e1568c00aaab0000aaab for BLS12-381 G1, and 1 for the other
+// groups for which this is a no-op.
+func (e *Element) ClearCofactor() *Element {
+	e.Element.ClearCofactor()
+	return e
+}
+
+// IsValid returns whether the element is on the curve, in the prime-order subgroup, and not the identity. Protocols
+// handling untrusted points should use it: in groups with a cofactor, like Edwards25519 and Edwards448, Decode accepts
+// small-order and mixed-order points, which IsValid rejects. BLS12-381 G1 has a cofactor too, but its Decode already
+// rejects points outside of the prime-order subgroup. The identity is mathematically in the prime-order
+// subgroup, but it is rejected here as Decode does.
+func (e *Element) IsValid() bool {
+	return e.Element.IsValid()
+}
+
+// IsBasePoint returns whether the element is the group's base point, comparing it to a base point cached at the group's
+// initialization rather than to a new one. The comparison is constant time in the backends where Equal is.
+func (e *Element) IsBasePoint() bool {
+	return e.Element.Equal(e.Group().base()) == 1
+}
+
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 func (e *Element) IsIdentity() bool {
 	return e.Element.IsIdentity()
 }
 
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns the receiver,
+// without branching on choice, which is meant to be secret. The behavior is undefined for other values of choice. The
+// NIST, Ristretto255, and Edwards25519 implementations are constant time, whereas the Decaf448, Edwards448,
+// BLS12-381 G1, Pallas, Vesta, and Secp256k1 backends rely on big.Int based implementations that are not.
+func (e *Element) CMov(element *Element, choice int) *Element {
+	if element == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	e.Element.CMov(element.Element, choice)
+
+	return e
+}
+
+// CondNegate sets the receiver to its negation if choice is 1, leaves it unchanged if choice is 0, and returns the
+// receiver. The negation is always computed and selected with CMov, so this is constant time where CMov is. The
+// behavior is undefined for other values of choice.
+func (e *Element) CondNegate(choice int) *Element {
+	e.Element.CMov(e.Element.Copy().Negate(), choice)
+
+	return e
+}
+
+// CSwap exchanges the values of the receiver and element if choice is 1, and leaves them unchanged if choice is 0. Both
+// are selected with CMov, so this is constant time where CMov is. The behavior is undefined for other values of choice.
+func (e *Element) CSwap(element *Element, choice int) {
+	if element == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	t := e.Element.Copy()
+	e.Element.CMov(element.Element, choice)
+	element.Element.CMov(t, choice)
+}
+
 // Set sets the receiver to the argument, and returns the receiver.
 func (e *Element) Set(element *Element) *Element {
 	if element == nil {
@@ -128,10 +295,149 @@ func (e *Element) XCoordinate() []byte {
 	return e.Element.XCoordinate()
 }
 
-// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+// YCoordinate returns the encoded y coordinate of the element, to be paired with XCoordinate:
+//   - for the NIST groups, Secp256k1, and BLS12-381 G1, it's the big-endian encoding of the affine y coordinate, and
+//     zeros for the identity;
+//   - for Edwards25519 and Edwards448, it's the little-endian encoding of the Montgomery v coordinate matching the u
+//     coordinate returned by XCoordinate;
+//   - for Pallas and Vesta, it's the little-endian encoding of the affine y coordinate, and zeros for the identity;
+//   - for Ristretto255, whose elements have no coordinates, it returns nil.
+func (e *Element) YCoordinate() []byte {
+	return e.Element.YCoordinate()
+}
+
+// AffineCoordinates returns the big-endian encoded affine x and y coordinates of the element, for the NIST groups and
+// Secp256k1. It returns an error wrapping internal.ErrIdentity for the identity, which has no affine representation,
+// and an error wrapping errors.ErrUnsupported for the other groups, which have no SEC1 encoding.
+func (e *Element) AffineCoordinates() (x, y []byte, err error) {
+	x, y, err = e.affineCoordinates()
+	if err != nil {
+		return nil, nil, fmt.Errorf("element AffineCoordinates: %w", err)
+	}
+
+	return x, y, nil
+}
+
+func (e *Element) affineCoordinates() (x, y []byte, err error) {
+	if !e.Group().hasSEC1() {
+		return nil, nil, fmt.Errorf("%w for %s", errors.ErrUnsupported, e.Group())
+	}
+
+	if e.IsIdentity() {
+		return nil, nil, internal.ErrIdentity
+	}
+
+	return e.Element.XCoordinate(), e.Element.YCoordinate(), nil
+}
+
+// EncodeUncompressed returns the uncompressed SEC1 encoding of the element, 0x04 || x || y, for the NIST groups and
+// Secp256k1. As AffineCoordinates, it returns an error for the identity and for the other groups, which have no SEC1
+// encoding.
+func (e *Element) EncodeUncompressed() ([]byte, error) {
+	x, y, err := e.affineCoordinates()
+	if err != nil {
+		return nil, fmt.Errorf("element EncodeUncompressed: %w", err)
+	}
+
+	out := make([]byte, 0, 1+len(x)+len(y))
+	out = append(out, 0x04)
+	out = append(out, x...)
+
+	return append(out, y...), nil
+}
+
+// DecodeUncompressed sets the receiver to the decoding of the uncompressed SEC1 encoding 0x04 || x || y, for the NIST
+// groups and Secp256k1, and returns an error on failure, leaving the receiver untouched. It returns an error wrapping
+// errors.ErrUnsupported for the other groups, which have no SEC1 encoding.
+func (e *Element) DecodeUncompressed(data []byte) error {
+	g := e.Group()
+	if !g.hasSEC1() {
+		return fmt.Errorf("element DecodeUncompressed: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	length := g.ElementLength() - 1
+	if len(data) != 1+2*length || data[0] != 0x04 {
+		return fmt.Errorf("element DecodeUncompressed: %w", internal.ErrParamInvalidPointEncoding)
+	}
+
+	if err := e.setAffine(data[1:1+length], data[1+length:]); err != nil {
+		return fmt.Errorf("element DecodeUncompressed: %w", elementDecodingError(err))
+	}
+
+	return nil
+}
+
+// SetCoordinates sets the receiver to the point with the big-endian encoded affine coordinates x and y, for the NIST
+// groups and Secp256k1, and returns an error on failure, leaving the receiver untouched. Both coordinates must have the
+// length of a field element. It returns an error wrapping ErrPointNotOnCurve if (x, y) is not on the curve, which for
+// these cofactor 1 curves is the same as not being in the prime-order group, and an error wrapping
+// errors.ErrUnsupported for the other groups, which have no SEC1 encoding.
+func (e *Element) SetCoordinates(x, y []byte) error {
+	g := e.Group()
+	if !g.hasSEC1() {
+		return fmt.Errorf("element SetCoordinates: %w for %s", errors.ErrUnsupported, g)
+	}
+
+	length := g.ElementLength() - 1
+	if len(x) != length || len(y) != length {
+		return fmt.Errorf("element SetCoordinates: %w", internal.ErrParamInvalidPointEncoding)
+	}
+
+	if err := e.setAffine(x, y); err != nil {
+		return fmt.Errorf("element SetCoordinates: %w", err)
+	}
+
+	return nil
+}
+
+// setAffine sets the receiver to the point with the big-endian encoded affine coordinates x and y, which must have the
+// length of a field element, and returns an error if the point is not on the curve.
+func (e *Element) setAffine(x, y []byte) error {
+	if len(y) == 0 {
+		return internal.ErrParamInvalidPointEncoding
+	}
+
+	// Decoding the compressed form verifies x and recovers the y with the same parity, which must be the given y. All
+	// groups with SEC1 encodings have cofactor 1, so any point on the curve is in the prime-order group.
+	compressed := make([]byte, 0, 1+len(x))
+	compressed = append(compressed, 0x02|y[len(y)-1]&1)
+	compressed = append(compressed, x...)
+
+	p := e.Group().NewElement()
+	if err := p.Element.Decode(compressed); err != nil {
+		return fmt.Errorf("%w: %w", internal.ErrPointNotOnCurve, err)
+	}
+
+	if subtle.ConstantTimeCompare(p.Element.YCoordinate(), y) != 1 {
+		return internal.ErrPointNotOnCurve
+	}
+
+	e.Element.Set(p.Element)
+
+	return nil
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure. On failure, the receiver
+// is left unchanged, never half-decoded, and so it is for all the decoding methods of Element.
 func (e *Element) Decode(data []byte) error {
 	if err := e.Element.Decode(data); err != nil {
-		return fmt.Errorf("element Decode: %w", err)
+		return fmt.Errorf("element Decode: %w", elementDecodingError(err))
+	}
+
+	return nil
+}
+
+// DecodeAllowIdentity sets the receiver to a decoding of the input data, and returns an error on failure. Unlike Decode,
+// which rejects the identity in all groups, it also accepts the encoding of the identity as returned by Encode, e.g. to
+// deserialize aggregates that may legitimately be the identity. No other encoding of the identity is accepted.
+func (e *Element) DecodeAllowIdentity(data []byte) error {
+	if subtle.ConstantTimeCompare(data, e.Group().NewElement().Encode()) == 1 {
+		e.Element.Identity()
+		return nil
+	}
+
+	if err := e.Element.Decode(data); err != nil {
+		return fmt.Errorf("element DecodeAllowIdentity: %w", elementDecodingError(err))
 	}
 
 	return nil
@@ -142,10 +448,39 @@ func (e *Element) Hex() string {
 	return e.Element.Hex()
 }
 
+// String returns the name of the element's group and its hexadecimal encoding, e.g. "P256Sha256:03ab...", so that
+// elements are readable and tagged with their group when printed with fmt.
+func (e *Element) String() string {
+	return e.Group().name() + ":" + e.Hex()
+}
+
 // DecodeHex sets e to the decoding of the hex encoded element.
 func (e *Element) DecodeHex(h string) error {
 	if err := e.Element.DecodeHex(h); err != nil {
-		return fmt.Errorf("element DecodeHex: %w", err)
+		return fmt.Errorf("element DecodeHex: %w", elementDecodingError(err))
+	}
+
+	return nil
+}
+
+// Base64 returns the unpadded base64url encoding of e.
+func (e *Element) Base64() string {
+	return base64.RawURLEncoding.EncodeToString(e.Element.Encode())
+}
+
+// DecodeBase64 sets e to the decoding of the unpadded base64url encoded element.
+func (e *Element) DecodeBase64(b string) error {
+	d, err := base64.RawURLEncoding.DecodeString(b)
+	if err != nil {
+		return fmt.Errorf("element DecodeBase64: %w", elementDecodingError(err))
+	}
+
+	if len(d) != e.Group().ElementLength() {
+		return fmt.Errorf("element DecodeBase64: %w", elementDecodingError(internal.ErrDecodingInvalidLength))
+	}
+
+	if err = e.Element.Decode(d); err != nil {
+		return fmt.Errorf("element DecodeBase64: %w", elementDecodingError(err))
 	}
 
 	return nil
@@ -162,6 +497,32 @@ func (e *Element) UnmarshalJSON(data []byte) error {
 	return e.DecodeHex(j)
 }
 
+// MarshalText implements the encoding.TextMarshaler interface, and returns the hexadecimal encoding of e.
+func (e *Element) MarshalText() ([]byte, error) {
+	return []byte(e.Hex()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, and sets e to the decoding of the hex encoded text.
+func (e *Element) UnmarshalText(text []byte) error {
+	if err := e.Element.DecodeHex(string(text)); err != nil {
+		return fmt.Errorf("element UnmarshalText: %w", elementDecodingError(err))
+	}
+
+	return nil
+}
+
+// AppendBinary appends the fixed-length byte encoding of e to b, and returns the extended buffer. It implements
+// encoding.BinaryAppender, sparing the copy of MarshalBinary when serializing many values into one buffer.
+func (e *Element) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, e.Element.Encode()...), nil
+}
+
+// AppendText appends the fixed-length hexadecimal encoding of e to b, and returns the extended buffer. It implements
+// encoding.TextAppender.
+func (e *Element) AppendText(b []byte) ([]byte, error) {
+	return hex.AppendEncode(b, e.Element.Encode()), nil
+}
+
 // MarshalBinary returns the compressed byte encoding of the element.
 func (e *Element) MarshalBinary() ([]byte, error) {
 	return e.Element.Encode(), nil
@@ -170,7 +531,7 @@ func (e *Element) MarshalBinary() ([]byte, error) {
 // UnmarshalBinary sets e to the decoding of the byte encoded element.
 func (e *Element) UnmarshalBinary(data []byte) error {
 	if err := e.Element.Decode(data); err != nil {
-		return fmt.Errorf("element UnmarshalBinary: %w", err)
+		return fmt.Errorf("element UnmarshalBinary: %w", elementDecodingError(err))
 	}
 
 	return nil
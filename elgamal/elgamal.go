@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package elgamal implements exponential ElGamal encryption over any ecc group, which is additively homomorphic: a
+// scalar message m is encrypted as the element m * G for the base point G, and the sum of the encryptions of two
+// messages is an encryption of their sum, e.g. to tally votes without decrypting them.
+//
+// Decrypt returns the element m * G rather than m, since recovering m is a discrete logarithm problem. It is only
+// tractable for small messages, which a Table recovers with the baby-step giant-step algorithm in time and memory
+// proportional to the square root of the message space bound.
+package elgamal
+
+import (
+	"errors"
+	"math"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+// MaxBound is the largest message space bound of a Table, which then holds 2^24 elements. Larger tables wouldn't fit in
+// memory, and their number of steps would overflow in the bound's arithmetic.
+const MaxBound = 1 << 48
+
+var (
+	// ErrOutOfRange is returned by Table.Log when the element is not the encoding of a message within the table's
+	// bound.
+	ErrOutOfRange = errors.New("message out of the table's range")
+
+	// ErrBoundTooLarge is the panic value of NewTable for bounds above MaxBound.
+	ErrBoundTooLarge = errors.New("table bound is larger than MaxBound")
+)
+
+// Ciphertext is an ElGamal ciphertext (C1, C2) = (r * G, m * G + r * pk) for a random r.
+type Ciphertext struct {
+	C1 *ecc.Element
+	C2 *ecc.Element
+}
+
+// Encrypt returns the encryption of the message for the public key. It panics if an input is nil or of another group
+// than the public key, or if the public key is the identity.
+func Encrypt(pk *ecc.Element, m *ecc.Scalar) Ciphertext {
+	if pk == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	if m == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	if pk.IsIdentity() {
+		panic(internal.ErrIdentity)
+	}
+
+	g := pk.Group()
+	if m.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	r := g.NewScalar().Random()
+
+	return Ciphertext{
+		C1: g.ScalarBaseMult(r),
+		C2: g.ScalarBaseMult(m).Add(pk.Copy().Multiply(r)),
+	}
+}
+
+// Decrypt returns the element m * G encoding the message of the ciphertext, i.e. C2 - sk * C1. Use a Table to recover
+// small messages from it. It panics if an input is nil or of another group than the secret key.
+func Decrypt(sk *ecc.Scalar, ct Ciphertext) *ecc.Element {
+	if sk == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	checkCiphertext(sk.Group(), ct)
+
+	return ct.C2.Copy().Subtract(ct.C1.Copy().Multiply(sk))
+}
+
+// Add returns the encryption of the sum of the messages of the two ciphertexts, which must be encrypted for the same
+// public key. It panics if an input is nil or of another group.
+func Add(ct1, ct2 Ciphertext) Ciphertext {
+	if ct1.C1 == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	g := ct1.C1.Group()
+	checkCiphertext(g, ct1)
+	checkCiphertext(g, ct2)
+
+	return Ciphertext{
+		C1: ct1.C1.Copy().Add(ct2.C1),
+		C2: ct1.C2.Copy().Add(ct2.C2),
+	}
+}
+
+func checkCiphertext(g ecc.Group, ct Ciphertext) {
+	if ct.C1 == nil || ct.C2 == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	if ct.C1.Group() != g || ct.C2.Group() != g {
+		panic(internal.ErrCastElement)
+	}
+}
+
+// Table recovers the messages in [0, bound] from their element encodings with the baby-step giant-step algorithm. It
+// holds about sqrt(bound) precomputed elements, and each lookup computes as many group additions at most.
+type Table struct {
+	baby  map[string]uint64
+	giant *ecc.Element
+	bound uint64
+	steps uint64
+}
+
+// NewTable returns a table recovering the messages in [0, bound] in the group. It panics with ErrBoundTooLarge if
+// bound is above MaxBound.
+func NewTable(g ecc.Group, bound uint64) *Table {
+	if bound > MaxBound {
+		panic(ErrBoundTooLarge)
+	}
+
+	steps := uint64(math.Sqrt(float64(bound))) + 1
+	for steps*steps <= bound {
+		steps++
+	}
+
+	t := &Table{
+		baby:  make(map[string]uint64, steps),
+		giant: g.NewElement(),
+		bound: bound,
+		steps: steps,
+	}
+
+	// The baby steps are j * G for j in [0, steps), and the giant step is -steps * G.
+	e := g.NewElement()
+	for j := range steps {
+		t.baby[string(e.Encode())] = j
+		e.Add(g.Base())
+	}
+
+	t.giant = e.Negate()
+
+	return t
+}
+
+// Log returns the message m in [0, bound] such that e = m * G, or ErrOutOfRange if there's none. It runs in variable
+// time, which leaks information about m.
+func (t *Table) Log(e *ecc.Element) (uint64, error) {
+	if e == nil {
+		return 0, internal.ErrParamNilPoint
+	}
+
+	if e.Group() != t.giant.Group() {
+		return 0, internal.ErrCastElement
+	}
+
+	// With m = i * steps + j, e - i * steps * G = j * G is a baby step.
+	cur := e.Copy()
+	for i := range t.steps {
+		if j, ok := t.baby[string(cur.Encode())]; ok {
+			if m := i*t.steps + j; m <= t.bound {
+				return m, nil
+			}
+
+			break
+		}
+
+		cur.Add(t.giant)
+	}
+
+	return 0, ErrOutOfRange
+}
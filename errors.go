@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import "github.com/0xBridge/ecc/internal"
+
+var (
+	// ErrInvalidScalarEncoding is matched by errors.Is on all the errors of scalar decoding, e.g. of a value that is
+	// not below the group order, of a wrong length, or of invalid hex or base64.
+	ErrInvalidScalarEncoding = internal.ErrParamScalarInvalidEncoding
+
+	// ErrInvalidScalarLength is matched by errors.Is on the errors of scalar decoding of a wrong length.
+	ErrInvalidScalarLength = internal.ErrParamScalarLength
+
+	// ErrInvalidPointEncoding is matched by errors.Is on all the errors of element decoding, including the rejection
+	// of the identity.
+	ErrInvalidPointEncoding = internal.ErrParamInvalidPointEncoding
+
+	// ErrWrongGroup is matched by errors.Is on the errors and panics of operations mixing scalars or elements of
+	// different groups.
+	ErrWrongGroup = internal.ErrWrongGroup
+)
+
+// decodingError keeps the message of a decoding error, and additionally matches the sentinel error of its kind with
+// errors.Is, whatever the backend returned.
+type decodingError struct {
+	err      error
+	sentinel error
+}
+
+func (e *decodingError) Error() string {
+	return e.err.Error()
+}
+
+func (e *decodingError) Unwrap() []error {
+	return []error{e.err, e.sentinel}
+}
+
+// scalarDecodingError returns err, matching ErrInvalidScalarEncoding.
+func scalarDecodingError(err error) error {
+	return &decodingError{err: err, sentinel: ErrInvalidScalarEncoding}
+}
+
+// elementDecodingError returns err, matching ErrInvalidPointEncoding.
+func elementDecodingError(err error) error {
+	return &decodingError{err: err, sentinel: ErrInvalidPointEncoding}
+}
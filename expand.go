@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"crypto"
+	"errors"
+
+	"github.com/0xBridge/hash2curve"
+	"github.com/bytemare/hash"
+)
+
+// maxExpandLength is the largest output length of expand_message in RFC9380, which encodes it on two bytes.
+const maxExpandLength = 1<<16 - 1
+
+var (
+	errExpandLength    = errors.New("invalid expand_message output length")
+	errUnavailableHash = errors.New("hash function is not available")
+	errUnsupportedXOF  = errors.New("unsupported extendable-output function")
+)
+
+// ExpandMessage returns length uniformly random bytes expanded from the input and the DST with the RFC9380
+// expand_message_xmd function instantiated with the hash function h, as the hash-to-curve suites with XMD in their
+// identifier do. DSTs longer than 255 bytes are replaced by the hash of "H2C-OVERSIZE-DST-" || DST, as specified in
+// RFC9380 section 5.3.3. It panics if the DST is empty, if h is not available, or if length is not between 1 and
+// 255 times the output size of h.
+func ExpandMessage(h crypto.Hash, input, dst []byte, length int) []byte {
+	checkDST(dst)
+
+	if !h.Available() {
+		panic(errUnavailableHash)
+	}
+
+	if length < 1 || length > 255*h.Size() || length > maxExpandLength {
+		panic(errExpandLength)
+	}
+
+	return hash2curve.ExpandXMD(h, input, dst, uint(length))
+}
+
+// ExpandMessageXOF returns length uniformly random bytes expanded from the input and the DST with the RFC9380
+// expand_message_xof function instantiated with the extendable-output function xof, i.e. hash.SHAKE128, or
+// hash.SHAKE256 as in the edwards448 and decaf448 suites. DSTs longer than 255 bytes are replaced by the hash of
+// "H2C-OVERSIZE-DST-" || DST, as specified in RFC9380 section 5.3.3. It panics if the DST is empty, if xof is another
+// function, or if length is not between 1 and 65535.
+func ExpandMessageXOF(xof hash.Hash, input, dst []byte, length int) []byte {
+	checkDST(dst)
+
+	if xof != hash.SHAKE128 && xof != hash.SHAKE256 {
+		panic(errUnsupportedXOF)
+	}
+
+	if length < 1 || length > maxExpandLength {
+		panic(errExpandLength)
+	}
+
+	return hash2curve.ExpandXOF(xof.GetXOF(), input, dst, uint(length))
+}
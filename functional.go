@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import "github.com/0xBridge/ecc/internal"
+
+// The functions in this file are non-mutating counterparts of the Scalar and Element methods: they leave their inputs
+// untouched and return a newly allocated result. Operands must be non-nil, and operands of different groups panic with
+// the same errors as the methods.
+
+func checkScalars(a, b *Scalar) {
+	if a == nil || b == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+}
+
+func checkElements(a, b *Element) {
+	if a == nil || b == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	if a.Group() != b.Group() {
+		panic(internal.ErrCastElement)
+	}
+}
+
+// AddScalars returns a new scalar set to a + b.
+func AddScalars(a, b *Scalar) *Scalar {
+	checkScalars(a, b)
+	return a.Copy().Add(b)
+}
+
+// SubScalars returns a new scalar set to a - b.
+func SubScalars(a, b *Scalar) *Scalar {
+	checkScalars(a, b)
+	return a.Copy().Subtract(b)
+}
+
+// MulScalars returns a new scalar set to a * b.
+func MulScalars(a, b *Scalar) *Scalar {
+	checkScalars(a, b)
+	return a.Copy().Multiply(b)
+}
+
+// AddElements returns a new element set to a + b.
+func AddElements(a, b *Element) *Element {
+	checkElements(a, b)
+	return a.Copy().Add(b)
+}
+
+// SubElements returns a new element set to a - b.
+func SubElements(a, b *Element) *Element {
+	checkElements(a, b)
+	return a.Copy().Subtract(b)
+}
+
+// NegateElement returns a new element set to -e.
+func NegateElement(e *Element) *Element {
+	if e == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	return e.Copy().Negate()
+}
+
+// ScalarMult returns a new element set to s * e.
+//
+// Deprecated: use ScalarMultNew, which does the same.
+func ScalarMult(e *Element, s *Scalar) *Element {
+	return ScalarMultNew(e, s)
+}
+
+// ScalarMultNew returns a new element set to s * e, leaving e unmodified. It panics if e or s is nil, or if they are of
+// different groups.
+func ScalarMultNew(e *Element, s *Scalar) *Element {
+	if e == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	if s == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	if s.Group() != e.Group() {
+		panic(internal.ErrCastScalar)
+	}
+
+	return e.Copy().Multiply(s)
+}
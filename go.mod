@@ -7,11 +7,9 @@ require (
 	filippo.io/nistec v0.0.3
 	github.com/0xBridge/hash2curve v0.0.0-20250115122726-bb6e1c72e812
 	github.com/0xBridge/secp256k1 v0.0.0-20250115122817-ec0fce38a0f8
+	github.com/bytemare/hash v0.4.0
 	github.com/gtank/ristretto255 v0.1.2
+	golang.org/x/crypto v0.32.0
 )
 
-require (
-	github.com/bytemare/hash v0.4.0 // indirect
-	golang.org/x/crypto v0.32.0 // indirect
-	golang.org/x/sys v0.29.0 // indirect
-)
+require golang.org/x/sys v0.29.0 // indirect
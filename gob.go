@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// decodeGroupEncoding sets the receiver to the decoding of the element of group g. A zero Element is set to an element
+// of g, and an error is returned if the receiver already belongs to another group.
+func (e *Element) decodeGroupEncoding(g Group, encoding []byte) error {
+	if e.Element != nil && e.Group() != g {
+		return internal.ErrCastElement
+	}
+
+	d := g.NewElement()
+	if err := d.Element.Decode(encoding); err != nil {
+		return err
+	}
+
+	e.Element = d.Element
+
+	return nil
+}
+
+// decodeGroupEncoding sets the receiver to the decoding of the scalar of group g. A zero Scalar is set to a scalar of
+// g, and an error is returned if the receiver already belongs to another group.
+func (s *Scalar) decodeGroupEncoding(g Group, encoding []byte) error {
+	if s.Scalar != nil && s.Group() != g {
+		return internal.ErrCastScalar
+	}
+
+	d := g.NewScalar()
+	if err := d.Scalar.Decode(encoding); err != nil {
+		return err
+	}
+
+	s.Scalar = d.Scalar
+
+	return nil
+}
+
+// splitGroupEncoding returns the group identifier prefixing the encoding in data.
+func splitGroupEncoding(data []byte) (Group, []byte, error) {
+	if len(data) == 0 {
+		return 0, nil, internal.ErrDecodingInvalidLength
+	}
+
+	g := Group(data[0])
+	if !g.Available() {
+		return 0, nil, internal.ErrInvalidGroup
+	}
+
+	return g, data[1:], nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, and returns the group identifier followed by the encoding of the
+// element.
+func (e *Element) GobEncode() ([]byte, error) {
+	return append([]byte{byte(e.Group())}, e.Element.Encode()...), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface, and sets the receiver to the decoding of the output of GobEncode.
+// A zero Element is set to an element of the encoded group, and an error is returned if the receiver already belongs
+// to another group.
+func (e *Element) GobDecode(data []byte) error {
+	g, encoding, err := splitGroupEncoding(data)
+	if err != nil {
+		return fmt.Errorf("element GobDecode: %w", err)
+	}
+
+	if err = e.decodeGroupEncoding(g, encoding); err != nil {
+		return fmt.Errorf("element GobDecode: %w", err)
+	}
+
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface, and returns the group identifier followed by the encoding of the
+// scalar.
+func (s *Scalar) GobEncode() ([]byte, error) {
+	return append([]byte{byte(s.Group())}, s.Scalar.Encode()...), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface, and sets the receiver to the decoding of the output of GobEncode.
+// A zero Scalar is set to a scalar of the encoded group, and an error is returned if the receiver already belongs to
+// another group.
+func (s *Scalar) GobDecode(data []byte) error {
+	g, encoding, err := splitGroupEncoding(data)
+	if err != nil {
+		return fmt.Errorf("scalar GobDecode: %w", err)
+	}
+
+	if err = s.decodeGroupEncoding(g, encoding); err != nil {
+		return fmt.Errorf("scalar GobDecode: %w", err)
+	}
+
+	return nil
+}
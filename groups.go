@@ -14,13 +14,19 @@ package ecc
 
 import (
 	"crypto"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/bls12381"
+	"github.com/0xBridge/ecc/internal/decaf448"
 	"github.com/0xBridge/ecc/internal/edwards25519"
+	"github.com/0xBridge/ecc/internal/edwards448"
 	"github.com/0xBridge/ecc/internal/nist"
+	"github.com/0xBridge/ecc/internal/pasta"
 	"github.com/0xBridge/ecc/internal/ristretto"
 	"github.com/0xBridge/ecc/internal/secp256k1"
 )
@@ -32,8 +38,10 @@ const (
 	// Ristretto255Sha512 identifies the Ristretto255 group with SHA2-512 hash-to-group hashing.
 	Ristretto255Sha512 Group = 1 + iota
 
-	// decaf448Shake256 is not implemented.
-	decaf448Shake256
+	// Decaf448Shake256 identifies the Decaf448 group with SHAKE256 hash-to-group hashing. Its backend is an internal,
+	// unaudited big.Int implementation that runs in variable time, and so is unsafe for secret scalars where timing
+	// side channels are a concern.
+	Decaf448Shake256
 
 	// P256Sha256 identifies a group over P256 with SHA2-256 hash-to-group hashing.
 	P256Sha256
@@ -50,6 +58,29 @@ const (
 	// Secp256k1Sha256 identifies the SECp256k1 group with SHA2-256 hash-to-group hashing.
 	Secp256k1Sha256
 
+	// P224Sha256 identifies a group over P224 with SHA2-256 hash-to-group hashing. RFC9380 doesn't define a
+	// hash-to-curve suite for P-224, so it uses P224_XMD:SHA-256_SSWU_RO_, following the other NIST suites.
+	P224Sha256
+
+	// Edwards448Shake256 identifies the Edwards448 group with SHAKE256 hash-to-group hashing. As for Decaf448, its
+	// backend runs in variable time and is unsafe for secret scalars where timing side channels are a concern.
+	Edwards448Shake256
+
+	// BLS12381G1Sha256 identifies the G1 group of the BLS12-381 pairing-friendly curve with SHA2-256 hash-to-group
+	// hashing. Its backend is an internal, unaudited big.Int implementation that runs in variable time, and so is
+	// unsafe for secret scalars where timing side channels are a concern.
+	BLS12381G1Sha256
+
+	// PallasSha256 identifies the Pallas group of the Pasta cycle of curves with SHA2-256 hash-to-group hashing. Its
+	// backend is an internal, unaudited big.Int implementation that runs in variable time, and so is unsafe for secret
+	// scalars where timing side channels are a concern.
+	PallasSha256
+
+	// VestaSha256 identifies the Vesta group of the Pasta cycle of curves with SHA2-256 hash-to-group hashing. It
+	// shares the variable-time backend of Pallas, and is unsafe for secret scalars where timing side channels are a
+	// concern.
+	VestaSha256
+
 	maxID
 
 	dstfmt               = "%s-V%02d-CS%02d-%s"
@@ -58,14 +89,66 @@ const (
 )
 
 var (
-	once          [maxID - 1]sync.Once
-	groups        [maxID - 1]internal.Group
-	errZeroLenDST = errors.New("zero-length DST")
+	once                  [maxID - 1]sync.Once
+	groups                [maxID - 1]internal.Group
+	bases                 [maxID - 1]internal.Element
+	errZeroLenDST         = errors.New("zero-length DST")
+	errUnknownCiphersuite = errors.New("unknown ciphersuite")
+	errNonPositiveCount   = errors.New("count must be positive")
 )
 
 // Available reports whether the given Group is linked into the binary.
 func (g Group) Available() bool {
-	return 0 < g && g < maxID && g != decaf448Shake256
+	return 0 < g && g < maxID
+}
+
+// SupportedCiphersuites returns the hash-to-curve ciphersuite identifiers of all the groups linked into the binary, in
+// the order of their Group identifiers. GroupFromString maps each of them back to its group.
+func SupportedCiphersuites() []string {
+	suites := make([]string, 0, maxID-1)
+
+	for g := Group(1); g < maxID; g++ {
+		if g.Available() {
+			suites = append(suites, g.String())
+		}
+	}
+
+	return suites
+}
+
+// GroupFromString returns the Group whose hash-to-curve ciphersuite identifier, as returned by String, is s. An error
+// is returned if none of the groups linked into the binary matches.
+func GroupFromString(s string) (Group, error) {
+	for g := Group(1); g < maxID; g++ {
+		if g.Available() && g.String() == s {
+			return g, nil
+		}
+	}
+
+	return 0, fmt.Errorf("GroupFromString: %w %q", errUnknownCiphersuite, s)
+}
+
+// hasSEC1 returns whether the group's elements are points of a cofactor 1 short Weierstrass curve with SEC1 encodings,
+// i.e. the NIST groups and Secp256k1. BLS12-381 G1, Pallas, and Vesta are Weierstrass curves too, but have their own
+// encodings.
+func (g Group) hasSEC1() bool {
+	switch g {
+	case P224Sha256, P256Sha256, P384Sha384, P521Sha512, Secp256k1Sha256:
+		return true
+	default:
+		return false
+	}
+}
+
+// littleEndianScalars returns whether the group encodes its scalars in little-endian, as do Ristretto255, Decaf448,
+// Edwards25519, Edwards448, Pallas, and Vesta. The NIST groups, Secp256k1, and BLS12-381 G1 use big-endian encodings.
+func (g Group) littleEndianScalars() bool {
+	switch g {
+	case Ristretto255Sha512, Decaf448Shake256, Edwards25519Sha512, Edwards448Shake256, PallasSha256, VestaSha256:
+		return true
+	default:
+		return false
+	}
 }
 
 func (g Group) get() internal.Group {
@@ -90,6 +173,21 @@ func (g Group) String() string {
 	return g.get().Ciphersuite()
 }
 
+// groupNames holds the identifier names of the groups, in the order of their values.
+var groupNames = [maxID - 1]string{
+	"Ristretto255Sha512", "Decaf448Shake256", "P256Sha256", "P384Sha384", "P521Sha512", "Edwards25519Sha512",
+	"Secp256k1Sha256", "P224Sha256", "Edwards448Shake256", "BLS12381G1Sha256", "PallasSha256", "VestaSha256",
+}
+
+// name returns the name of the group's identifier, e.g. P256Sha256.
+func (g Group) name() string {
+	if !g.Available() {
+		panic(internal.ErrInvalidGroup)
+	}
+
+	return groupNames[g-1]
+}
+
 // NewScalar returns a new scalar set to 0.
 func (g Group) NewScalar() *Scalar {
 	return newScalar(g.get().NewScalar())
@@ -100,11 +198,73 @@ func (g Group) NewElement() *Element {
 	return newPoint(g.get().NewElement())
 }
 
+// NewScalarFromUint64 returns a new scalar set to n, reduced modulo the group order.
+func (g Group) NewScalarFromUint64(n uint64) *Scalar {
+	return g.NewScalar().SetUInt64(n)
+}
+
+// ScalarFromHex returns a new scalar set to the decoding of the hex encoded scalar h, or an error matching
+// ErrInvalidScalarEncoding on failure.
+func (g Group) ScalarFromHex(h string) (*Scalar, error) {
+	s := g.NewScalar()
+	if err := s.Scalar.DecodeHex(h); err != nil {
+		return nil, fmt.Errorf("ScalarFromHex: %w", scalarDecodingError(err))
+	}
+
+	return s, nil
+}
+
+// ElementFromHex returns a new element set to the decoding of the hex encoded element h, or an error matching
+// ErrInvalidPointEncoding on failure. As with Decode, the identity element is rejected.
+func (g Group) ElementFromHex(h string) (*Element, error) {
+	e := g.NewElement()
+	if err := e.Element.DecodeHex(h); err != nil {
+		return nil, fmt.Errorf("ElementFromHex: %w", elementDecodingError(err))
+	}
+
+	return e, nil
+}
+
+// RandomScalar returns a new random scalar, i.e. NewScalar().Random(): the random source is crypto/rand, and the scalar
+// is never zero.
+func (g Group) RandomScalar() *Scalar {
+	return g.NewScalar().Random()
+}
+
+// RandomElement returns a new random element, computed as the product of the base point and a RandomScalar, which is
+// thus never the identity. The caller doesn't learn its discrete logarithm, but the computation does: where no one may
+// know it, e.g. for a second generator of Pedersen commitments, use HashToGroup instead.
+func (g Group) RandomElement() *Element {
+	return g.ScalarBaseMult(g.RandomScalar())
+}
+
 // Base returns the group's base point a.k.a. canonical generator.
 func (g Group) Base() *Element {
 	return newPoint(g.get().Base())
 }
 
+// ScalarBaseMult returns a new element set to the product of the group's base point and the scalar, relying on
+// precomputed multiples of the base point, from the group's backend or from BaseTable. Element.Multiply uses it too
+// when the receiver is the base point. A nil scalar yields the identity element. It panics if the scalar does not
+// belong to the group.
+func (g Group) ScalarBaseMult(s *Scalar) *Element {
+	if s == nil {
+		return g.NewElement()
+	}
+
+	if s.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	if !g.hasBaseTable() {
+		return g.BaseTable().Multiply(s)
+	}
+
+	return newPoint(g.get().ScalarBaseMult(s.Scalar))
+}
+
+// checkDST panics on empty DSTs. DSTs longer than 255 bytes are accepted, and replaced by the expanders with the hash
+// of "H2C-OVERSIZE-DST-" || DST, as specified in RFC9380 section 5.3.3.
 func checkDST(dst []byte) {
 	if len(dst) < recommendedMinLength {
 		if len(dst) == minLength {
@@ -125,6 +285,51 @@ func (g Group) HashToScalar(input, dst []byte) *Scalar {
 	return newScalar(g.get().HashToScalar(input, dst))
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars. The input is expanded once
+// to count times the length HashToScalar uses, and each chunk is reduced to a scalar, as the RFC9380 hash_to_field
+// function does, so that HashToScalars(input, dst, 1)[0] equals HashToScalar(input, dst).
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes, and count must be positive.
+func (g Group) HashToScalars(input, dst []byte, count int) []*Scalar {
+	checkDST(dst)
+
+	if count < 1 {
+		panic(errNonPositiveCount)
+	}
+
+	s := g.get().HashToScalars(input, dst, uint(count))
+	out := make([]*Scalar, len(s))
+
+	for i, si := range s {
+		out[i] = newScalar(si)
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field of the group's curve produced by the RFC9380 hash_to_field
+// function, with the expansion parameters of the group's hash-to-curve suite, each encoded in big-endian with the
+// length of FieldPrime. These are the u values of the RFC9380 test vectors. Ristretto255 and Decaf448 don't use
+// hash_to_field to map to the group, so the parameters of edwards25519_XMD:SHA-512_ELL2_RO_ and
+// edwards448_XOF:SHAKE256_ELL2_RO_ are used respectively.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes, and count must be positive.
+func (g Group) HashToField(input, dst []byte, count int) [][]byte {
+	checkDST(dst)
+
+	if count < 1 {
+		panic(errNonPositiveCount)
+	}
+
+	length := len(g.FieldPrime())
+	u := g.get().HashToField(input, dst, uint(count))
+	out := make([][]byte, len(u))
+
+	for i, e := range u {
+		out[i] = e.FillBytes(make([]byte, length))
+	}
+
+	return out
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) *Element {
@@ -132,7 +337,67 @@ func (g Group) HashToGroup(input, dst []byte) *Element {
 	return newPoint(g.get().HashToGroup(input, dst))
 }
 
-// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
+// BatchHashToGroup returns the mappings of each of the inputs to an Element in the Group, which are the same as those
+// of HashToGroup(input, dst). The NIST groups share a single field inversion across the SSWU maps of all inputs, and
+// the other groups hash the inputs in turn. The DST must not be empty or nil, and is recommended to be longer than 16
+// bytes.
+func (g Group) BatchHashToGroup(inputs [][]byte, dst []byte) []*Element {
+	checkDST(dst)
+
+	out := make([]*Element, len(inputs))
+
+	if b, ok := g.get().(internal.BatchGroupHasher); ok {
+		for i, e := range b.BatchHashToGroup(inputs, dst) {
+			out[i] = newPoint(e)
+		}
+
+		return out
+	}
+
+	for i, input := range inputs {
+		out[i] = newPoint(g.get().HashToGroup(input, dst))
+	}
+
+	return out
+}
+
+// HashToGenerators returns n generators of the Group with no known discrete logarithm relation to each other or to the
+// base point, e.g. for Pedersen vector commitments. The i-th generator is HashToGroup(LE64(i), dst), where LE64(i) is
+// the 8-byte little-endian encoding of i, so that they are the same across runs and machines. They are distinct from
+// each other and from the base point with overwhelming probability.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes, and n must be positive.
+func (g Group) HashToGenerators(n int, dst []byte) []*Element {
+	checkDST(dst)
+
+	if n < 1 {
+		panic(errNonPositiveCount)
+	}
+
+	inputs := make([][]byte, n)
+	for i := range inputs {
+		inputs[i] = binary.LittleEndian.AppendUint64(nil, uint64(i))
+	}
+
+	return g.BatchHashToGroup(inputs, dst)
+}
+
+// HashToGroupDST returns HashToGroup(input, MakeDST(app, version)), i.e. a safe mapping of the arbitrary input to an
+// Element in the Group with a domain separation tag built from the application name and version and the group's
+// ciphersuite, sparing callers the assembly of a conformant and unique DST.
+func (g Group) HashToGroupDST(input []byte, app string, version uint8) *Element {
+	return g.HashToGroup(input, g.MakeDST(app, version))
+}
+
+// HashToScalarDST returns HashToScalar(input, MakeDST(app, version)), with the same domain separation tag as
+// HashToGroupDST.
+func (g Group) HashToScalarDST(input []byte, app string, version uint8) *Scalar {
+	return g.HashToScalar(input, g.MakeDST(app, version))
+}
+
+// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group, i.e. the
+// encode_to_curve function of the group's RFC9380 _NU_ suite, which maps a single field element and is cheaper than
+// HashToGroup.
+// Ristretto255 and Decaf448 have no such suite, so it's the same as HashToGroup for them.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) EncodeToGroup(input, dst []byte) *Element {
 	checkDST(dst)
@@ -154,14 +419,51 @@ func (g Group) Order() []byte {
 	return g.get().Order()
 }
 
+// OrderBigInt returns the order of the canonical group of scalars as a big.Int, sparing the caller the endianness of
+// the encoding returned by Order.
+func (g Group) OrderBigInt() *big.Int {
+	order := g.Order()
+	if g.littleEndianScalars() {
+		order = internal.Reverse(order)
+	}
+
+	return new(big.Int).SetBytes(order)
+}
+
+// FieldPrime returns the big-endian encoding of the order p of the base field of the group's underlying curve,
+// regardless of the endianness of the group's own encodings. Ristretto255 and Decaf448 abstract away their underlying
+// curves, but their elements are still encoded as field elements modulo the primes of curve25519 (2^255 - 19) and
+// edwards448 (2^448 - 2^224 - 1), which are returned.
+func (g Group) FieldPrime() []byte {
+	return g.get().FieldPrime()
+}
+
+// Cofactor returns the cofactor of the group, i.e. the ratio between the number of points on the underlying curve and
+// the order of the group, encoded as a scalar. It is 1 for the NIST groups, Secp256k1, Pallas, and Vesta, which have
+// prime order, and for Ristretto255 and Decaf448, which abstract away the cofactor of their underlying curves. It is 8
+// for Edwards25519, 4 for Edwards448, and 0x396c8c005555e1568c00aaab0000aaab for BLS12-381 G1, for which
+// Element.ClearCofactor maps points of the curve into the group.
+func (g Group) Cofactor() []byte {
+	return g.get().Cofactor()
+}
+
 func (g Group) initGroup(get func() internal.Group) {
 	groups[g-1] = get()
+	bases[g-1] = groups[g-1].Base()
+}
+
+// base returns the group's base point shared by all callers, which must not modify it.
+func (g Group) base() internal.Element {
+	g.get()
+	return bases[g-1]
 }
 
 func (g Group) init() {
 	switch g {
 	case Ristretto255Sha512:
 		g.initGroup(ristretto.New)
+	case Decaf448Shake256:
+		g.initGroup(decaf448.New)
 	case P256Sha256:
 		g.initGroup(nist.P256)
 	case P384Sha384:
@@ -172,6 +474,16 @@ func (g Group) init() {
 		g.initGroup(edwards25519.New)
 	case Secp256k1Sha256:
 		g.initGroup(secp256k1.New)
+	case P224Sha256:
+		g.initGroup(nist.P224)
+	case Edwards448Shake256:
+		g.initGroup(edwards448.New)
+	case BLS12381G1Sha256:
+		g.initGroup(bls12381.New)
+	case PallasSha256:
+		g.initGroup(pasta.Pallas)
+	case VestaSha256:
+		g.initGroup(pasta.Vesta)
 	default:
 		panic("group not recognized")
 	}
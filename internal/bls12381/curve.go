@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package bls12381
+
+import (
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+var (
+	// fieldPrime is the order of the base field of BLS12-381.
+	fieldPrime = field.String2Int("0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab")
+
+	fp = field.NewField(&fieldPrime)
+
+	// curveB is b in the curve equation y^2 = x^3 + b, and b3 = 3 * b.
+	curveB = big.NewInt(4)
+	b3     = big.NewInt(12)
+
+	// sqrtExponent = (p + 1) / 4, as p = 3 mod 4.
+	sqrtExponent = new(big.Int).Rsh(new(big.Int).Add(&fieldPrime, big.NewInt(1)), 2)
+
+	// pMinusOneHalf = (p - 1) / 2, the largest field element whose negation is larger than itself.
+	pMinusOneHalf = new(big.Int).Rsh(fp.PMinusOne(), 1)
+)
+
+func mul(x, y *big.Int) *big.Int {
+	return fp.Mod(new(big.Int).Mul(x, y))
+}
+
+func add(x, y *big.Int) *big.Int {
+	return fp.Mod(new(big.Int).Add(x, y))
+}
+
+func sub(x, y *big.Int) *big.Int {
+	return fp.Mod(new(big.Int).Sub(x, y))
+}
+
+func neg(x *big.Int) *big.Int {
+	return fp.Mod(new(big.Int).Neg(x))
+}
+
+func invert(x *big.Int) *big.Int {
+	res := new(big.Int)
+	fp.Inv(res, x)
+
+	return res
+}
+
+// sqrt returns a square root of x and true if x is a square, and false otherwise.
+func sqrt(x *big.Int) (*big.Int, bool) {
+	r := fp.Exponent(new(big.Int), x, sqrtExponent)
+	return r, mul(r, r).Cmp(x) == 0
+}
+
+// curveRHS returns x^3 + 4, the right-hand side of the curve equation.
+func curveRHS(x *big.Int) *big.Int {
+	return add(mul(mul(x, x), x), curveB)
+}
+
+// point is a point of the G1 curve y^2 = x^3 + 4 in homogeneous projective coordinates, with x = X/Z and y = Y/Z, and
+// where the identity is (0:1:0).
+type point struct {
+	x, y, z *big.Int
+}
+
+func identity() *point {
+	return &point{x: new(big.Int), y: big.NewInt(1), z: new(big.Int)}
+}
+
+func newAffinePoint(x, y *big.Int) *point {
+	return &point{x: x, y: y, z: big.NewInt(1)}
+}
+
+func (p *point) copy() *point {
+	return &point{
+		x: new(big.Int).Set(p.x),
+		y: new(big.Int).Set(p.y),
+		z: new(big.Int).Set(p.z),
+	}
+}
+
+// add returns p + q, using the complete addition formulas for a = 0 short Weierstrass curves of Renes, Costello, and
+// Batina (Algorithm 7 in https://eprint.iacr.org/2015/1060), which also hold for doubling and the identity.
+func (p *point) add(q *point) *point {
+	t0 := mul(p.x, q.x)
+	t1 := mul(p.y, q.y)
+	t2 := mul(p.z, q.z)
+	t3 := sub(mul(add(p.x, p.y), add(q.x, q.y)), add(t0, t1))
+	t4 := sub(mul(add(p.y, p.z), add(q.y, q.z)), add(t1, t2))
+	y3 := sub(mul(add(p.x, p.z), add(q.x, q.z)), add(t0, t2))
+	t0 = mul(big.NewInt(3), t0)
+	t2 = mul(b3, t2)
+	z3 := add(t1, t2)
+	t1 = sub(t1, t2)
+	y3 = mul(b3, y3)
+
+	return &point{
+		x: sub(mul(t3, t1), mul(t4, y3)),
+		y: add(mul(t1, z3), mul(y3, t0)),
+		z: add(mul(z3, t4), mul(t0, t3)),
+	}
+}
+
+func (p *point) double() *point {
+	return p.add(p)
+}
+
+func (p *point) negate() *point {
+	return &point{x: new(big.Int).Set(p.x), y: neg(p.y), z: new(big.Int).Set(p.z)}
+}
+
+// equal returns whether p and q are the same point, i.e. whether X1 * Z2 == X2 * Z1 and Y1 * Z2 == Y2 * Z1.
+func (p *point) equal(q *point) bool {
+	return mul(p.x, q.z).Cmp(mul(q.x, p.z)) == 0 && mul(p.y, q.z).Cmp(mul(q.y, p.z)) == 0
+}
+
+func (p *point) isIdentity() bool {
+	return p.z.Sign() == 0
+}
+
+// affine returns the affine coordinates of p, and (0, 0) for the identity.
+func (p *point) affine() (x, y *big.Int) {
+	if p.z.Cmp(big.NewInt(1)) == 0 {
+		return new(big.Int).Set(p.x), new(big.Int).Set(p.y)
+	}
+
+	zInv := invert(p.z)
+
+	return mul(p.x, zInv), mul(p.y, zInv)
+}
+
+// normalize sets the points to their representation with Z = 1, with a single inversion shared by all of them. The
+// identity is left as is.
+func normalize(points []*point) {
+	zInv := make([]*big.Int, len(points))
+	for i, p := range points {
+		zInv[i] = new(big.Int).Set(p.z)
+	}
+
+	fp.BatchInv(zInv)
+
+	for i, p := range points {
+		if p.isIdentity() {
+			continue
+		}
+
+		p.x, p.y, p.z = mul(p.x, zInv[i]), mul(p.y, zInv[i]), big.NewInt(1)
+	}
+}
+
+// multiplyWindow is the width, in bits, of the scalar digits in point multiplication.
+const multiplyWindow = 4
+
+// multiply returns s * p, with a fixed window over the big-endian encoded scalar s of any length.
+func multiply(s []byte, p *point) *point {
+	// table[d] = d * p, for 0 <= d < 2^multiplyWindow.
+	table := make([]*point, 1<<multiplyWindow)
+	table[0] = identity()
+
+	for d := 1; d < len(table); d++ {
+		table[d] = table[d-1].add(p)
+	}
+
+	result := identity()
+
+	for _, b := range s {
+		for _, digit := range []byte{b >> multiplyWindow, b & (1<<multiplyWindow - 1)} {
+			for range multiplyWindow {
+				result = result.double()
+			}
+
+			result = result.add(table[digit])
+		}
+	}
+
+	return result
+}
+
+// isTorsionFree returns whether p is in the prime-order subgroup, i.e. whether [order]p is the identity.
+func (p *point) isTorsionFree() bool {
+	return multiply(groupOrder.Bytes(), p).isIdentity()
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package bls12381
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	// The flags in the three most significant bits of the first byte of an encoded element.
+	flagCompressed = 0x80
+	flagInfinity   = 0x40
+	flagSign       = 0x20
+	flagMask       = flagCompressed | flagInfinity | flagSign
+)
+
+// Element implements the Element interface for the BLS12-381 G1 group element.
+type Element struct {
+	point *point
+}
+
+func newElement() *Element {
+	return &Element{point: identity()}
+}
+
+func checkElement(element internal.Element) *Element {
+	if element == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	ec, ok := element.(*Element)
+	if !ok {
+		panic(internal.ErrCastElement)
+	}
+
+	return ec
+}
+
+// encode returns the compressed encoding of p, i.e. the big-endian x coordinate with the compression flag, and the
+// sign flag set if y is lexicographically the largest of y and -y. The identity is encoded with the compression and
+// infinity flags, and all other bits set to 0.
+func encode(p *point) []byte {
+	out := make([]byte, elementLength)
+
+	if p.isIdentity() {
+		out[0] = flagCompressed | flagInfinity
+		return out
+	}
+
+	x, y := p.affine()
+	x.FillBytes(out)
+	out[0] |= flagCompressed
+
+	if y.Cmp(pMinusOneHalf) > 0 {
+		out[0] |= flagSign
+	}
+
+	return out
+}
+
+// decode returns the point encoded in data, which must be compressed and canonical. It does not check whether the point
+// is in the prime-order subgroup.
+func decode(data []byte) (*point, error) {
+	if len(data) != elementLength || data[0]&flagCompressed == 0 {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	flags := data[0] & flagMask
+	encoded := make([]byte, elementLength)
+	copy(encoded, data)
+	encoded[0] &^= flagMask
+
+	if flags&flagInfinity != 0 {
+		if flags&flagSign != 0 || subtle.ConstantTimeCompare(encoded, make([]byte, elementLength)) != 1 {
+			return nil, internal.ErrParamInvalidPointEncoding
+		}
+
+		return identity(), nil
+	}
+
+	x := new(big.Int).SetBytes(encoded)
+	if x.Cmp(&fieldPrime) >= 0 {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	y, ok := sqrt(curveRHS(x))
+	if !ok {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	if (y.Cmp(pMinusOneHalf) > 0) != (flags&flagSign != 0) {
+		y = neg(y)
+	}
+
+	return newAffinePoint(x, y), nil
+}
+
+// Group returns the group's Identifier.
+func (e *Element) Group() byte {
+	return Identifier
+}
+
+// Base sets the element to the group's base point a.k.a. canonical generator.
+func (e *Element) Base() internal.Element {
+	e.point = base.copy()
+	return e
+}
+
+// Identity sets the element to the point at infinity of the Group's underlying curve.
+func (e *Element) Identity() internal.Element {
+	e.point = identity()
+	return e
+}
+
+// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
+func (e *Element) Add(element internal.Element) internal.Element {
+	ec := checkElement(element)
+	e.point = e.point.add(ec.point)
+
+	return e
+}
+
+// Double sets the receiver to its double, and returns it.
+func (e *Element) Double() internal.Element {
+	e.point = e.point.double()
+	return e
+}
+
+// Negate sets the receiver to its negation, and returns it.
+func (e *Element) Negate() internal.Element {
+	e.point = e.point.negate()
+	return e
+}
+
+// Subtract subtracts the input from the receiver, and returns the receiver.
+func (e *Element) Subtract(element internal.Element) internal.Element {
+	ec := checkElement(element)
+	e.point = e.point.add(ec.point.negate())
+
+	return e
+}
+
+// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
+func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
+	if scalar == nil {
+		return e.Identity()
+	}
+
+	sc := assert(scalar)
+	e.point = multiply(sc.Encode(), e.point)
+
+	return e
+}
+
+// Equal returns 1 if the elements are equivalent, and 0 otherwise.
+func (e *Element) Equal(element internal.Element) int {
+	ec := checkElement(element)
+	if e.point.equal(ec.point) {
+		return 1
+	}
+
+	return 0
+}
+
+// ClearCofactor sets the receiver to its product with the cofactor of G1,
+// h = 0x396c8c005555e1568c00aaab0000aaab, and returns it.
+func (e *Element) ClearCofactor() internal.Element {
+	e.point = multiply(cofactor.Bytes(), e.point)
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order subgroup.
+func (e *Element) IsValid() bool {
+	return !e.IsIdentity() && e.point.isTorsionFree()
+}
+
+// IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
+func (e *Element) IsIdentity() bool {
+	return e.point.isIdentity()
+}
+
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it. The selection
+// is done on the fixed-size encodings of the coordinates without branching on choice, but the big.Int based arithmetic
+// of this backend is not constant time.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := checkElement(element)
+	dst := []*big.Int{e.point.x, e.point.y, e.point.z}
+	src := []*big.Int{ec.point.x, ec.point.y, ec.point.z}
+	p := make([]*big.Int, len(dst))
+
+	for i := range dst {
+		coordinate := dst[i].FillBytes(make([]byte, elementLength))
+		subtle.ConstantTimeCopy(choice, coordinate, src[i].FillBytes(make([]byte, elementLength)))
+		p[i] = new(big.Int).SetBytes(coordinate)
+	}
+
+	e.point = &point{x: p[0], y: p[1], z: p[2]}
+
+	return e
+}
+
+// Set sets the receiver to the value of the argument, and returns the receiver.
+func (e *Element) Set(element internal.Element) internal.Element {
+	if element == nil {
+		return e.Identity()
+	}
+
+	ec := checkElement(element)
+	e.point = ec.point.copy()
+
+	return e
+}
+
+// Copy returns a copy of the receiver.
+func (e *Element) Copy() internal.Element {
+	return &Element{point: e.point.copy()}
+}
+
+// Encode returns the compressed byte encoding of the element.
+func (e *Element) Encode() []byte {
+	return encode(e.point)
+}
+
+// XCoordinate returns the big-endian encoded affine x coordinate of the element, and zeros for the identity.
+func (e *Element) XCoordinate() []byte {
+	x, _ := e.point.affine()
+	return x.FillBytes(make([]byte, elementLength))
+}
+
+// YCoordinate returns the big-endian encoded affine y coordinate of the element, and zeros for the identity.
+func (e *Element) YCoordinate() []byte {
+	_, y := e.point.affine()
+	return y.FillBytes(make([]byte, elementLength))
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure. Points that are not in
+// the prime-order subgroup are rejected.
+func (e *Element) Decode(data []byte) error {
+	p, err := decode(data)
+	if err != nil {
+		return fmt.Errorf("invalid BLS12-381 G1 encoding: %w", err)
+	}
+
+	if p.isIdentity() {
+		return fmt.Errorf("invalid BLS12-381 G1 encoding: %w", internal.ErrIdentity)
+	}
+
+	if !p.isTorsionFree() {
+		return fmt.Errorf("invalid BLS12-381 G1 encoding: %w", internal.ErrPointNotInSubgroup)
+	}
+
+	e.point = p
+
+	return nil
+}
+
+// Hex returns the fixed-sized hexadecimal encoding of e.
+func (e *Element) Hex() string {
+	return hex.EncodeToString(e.Encode())
+}
+
+// DecodeHex sets e to the decoding of the hex encoded element.
+func (e *Element) DecodeHex(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return e.Decode(b)
+}
@@ -0,0 +1,178 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package bls12381 allows simple and abstracted operations in the G1 group of the BLS12-381 pairing-friendly curve.
+// Its field and curve arithmetic is implemented here with big.Int, is not constant time, and has not been audited.
+package bls12381
+
+import (
+	"crypto"
+	"math/big"
+
+	"github.com/0xBridge/hash2curve"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+const (
+	// Identifier distinguishes this group from the others by a byte representation.
+	Identifier = byte(10)
+
+	// scalarLength is the byte size of an encoded scalar.
+	scalarLength = 32
+
+	// elementLength is the byte size of a compressed element, as in the ZCash serialization format.
+	elementLength = 48
+)
+
+var (
+	// cofactor is the cofactor h of G1.
+	cofactor = field.String2Int("0x396c8c005555e1568c00aaab0000aaab")
+
+	// baseX and baseY are the affine coordinates of the generator of G1.
+	baseX = field.String2Int("0x17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb")
+	baseY = field.String2Int("0x08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1")
+
+	base = newAffinePoint(&baseX, &baseY)
+)
+
+// Group represents the G1 group of BLS12-381. It exposes a prime-order group API with hash-to-curve operations. This
+// implementation relies on big.Int arithmetic, and is therefore not constant time.
+type Group struct{}
+
+// New returns a new instantiation of the BLS12-381 G1 Group.
+func New() internal.Group {
+	return Group{}
+}
+
+// NewScalar returns a new scalar set to 0.
+func (g Group) NewScalar() internal.Scalar {
+	return newScalar()
+}
+
+// NewElement returns the identity element (point at infinity).
+func (g Group) NewElement() internal.Element {
+	return newElement()
+}
+
+// Base returns the group's base point a.k.a. canonical generator.
+func (g Group) Base() internal.Element {
+	return newElement().Base()
+}
+
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar.
+func (g Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	return newElement().Base().Multiply(scalar)
+}
+
+// HashFunc returns the RFC9380 associated hash function of the group.
+func (g Group) HashFunc() crypto.Hash {
+	return crypto.SHA256
+}
+
+// HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
+	s := newScalar()
+	s.scalar.Set(hashToScalar(input, dst))
+
+	return s
+}
+
+// BatchNormalize sets the elements to their affine representation, with a single field inversion shared by all of
+// them.
+func (g Group) BatchNormalize(elements []internal.Element) {
+	points := make([]*point, len(elements))
+	for i, e := range elements {
+		points[i] = checkElement(e).point
+	}
+
+	normalize(points)
+}
+
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g Group) UniformLengths() (element, scalar uint) {
+	return 2 * fieldSecLength, scalarSecLength
+}
+
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g Group) ElementFromUniform(uniform []byte) internal.Element {
+	return &Element{point: fromUniform(uniform)}
+}
+
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g Group) ScalarFromUniform(uniform []byte) internal.Scalar {
+	s := newScalar()
+	s.scalar.Set(scalarFromUniform(uniform))
+
+	return s
+}
+
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	u := hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, count, 1, scalarSecLength, &groupOrder)
+	out := make([]internal.Scalar, len(u))
+
+	for i, ui := range u {
+		s := newScalar()
+		s.scalar.Set(ui)
+		out[i] = s
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite.
+func (g Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, count, 1, fieldSecLength, &fieldPrime)
+}
+
+// HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToGroup(input, dst []byte) internal.Element {
+	return &Element{point: hashToG1(input, dst)}
+}
+
+// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
+	return &Element{point: encodeToG1(input, dst)}
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier.
+func (g Group) Ciphersuite() string {
+	return H2C
+}
+
+// ScalarLength returns the byte size of an encoded scalar.
+func (g Group) ScalarLength() int {
+	return scalarLength
+}
+
+// ElementLength returns the byte size of an encoded element.
+func (g Group) ElementLength() int {
+	return elementLength
+}
+
+// Order returns the order of the canonical group of scalars.
+func (g Group) Order() []byte {
+	return groupOrder.FillBytes(make([]byte, scalarLength))
+}
+
+// FieldPrime returns the big-endian encoding of the order of the base field of BLS12-381.
+func (g Group) FieldPrime() []byte {
+	return fieldPrime.FillBytes(make([]byte, elementLength))
+}
+
+// Cofactor returns the cofactor of G1, h = 0x396c8c005555e1568c00aaab0000aaab, encoded as a scalar.
+func (g Group) Cofactor() []byte {
+	return cofactor.FillBytes(make([]byte, scalarLength))
+}
@@ -0,0 +1,286 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package bls12381
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+var (
+	// groupOrder is the prime order r of the G1 subgroup, and of the scalar field.
+	groupOrder = field.String2Int("0x73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")
+
+	scalarField = field.NewField(&groupOrder)
+)
+
+// Scalar implements the Scalar interface for BLS12-381 scalars, with big-endian encodings.
+type Scalar struct {
+	scalar big.Int
+}
+
+func newScalar() *Scalar {
+	return &Scalar{}
+}
+
+func assert(scalar internal.Scalar) *Scalar {
+	sc, ok := scalar.(*Scalar)
+	if !ok {
+		panic(internal.ErrCastScalar)
+	}
+
+	return sc
+}
+
+// Group returns the group's Identifier.
+func (s *Scalar) Group() byte {
+	return Identifier
+}
+
+// Zero sets the scalar to 0, and returns it.
+func (s *Scalar) Zero() internal.Scalar {
+	s.scalar.SetUint64(0)
+	return s
+}
+
+// One sets the scalar to 1, and returns it.
+func (s *Scalar) One() internal.Scalar {
+	s.scalar.SetUint64(1)
+	return s
+}
+
+// MinusOne sets the scalar to order-1, and returns it.
+func (s *Scalar) MinusOne() internal.Scalar {
+	s.scalar.Set(scalarField.PMinusOne())
+	return s
+}
+
+// Random sets the current scalar to a new random scalar and returns it.
+// The random source is crypto/rand, and this functions is guaranteed to return a non-zero scalar.
+func (s *Scalar) Random() internal.Scalar {
+	for {
+		scalarField.Random(&s.scalar)
+
+		if !s.IsZero() {
+			return s
+		}
+	}
+}
+
+// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
+func (s *Scalar) Add(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s
+	}
+
+	sc := assert(scalar)
+	scalarField.Add(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// Subtract subtracts the input from the receiver, and returns the receiver.
+func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s
+	}
+
+	sc := assert(scalar)
+	scalarField.Sub(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// Multiply multiplies the receiver with the input, and returns the receiver.
+func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.Zero()
+	}
+
+	sc := assert(scalar)
+	scalarField.Mul(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+func (s *Scalar) MultiplyAdd(a, b internal.Scalar) internal.Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	sa := assert(a)
+	sb := assert(b)
+
+	// Use an intermediate value in case b aliases the receiver.
+	var product big.Int
+	product.Mul(&s.scalar, &sa.scalar)
+	scalarField.Add(&s.scalar, &product, &sb.scalar)
+
+	return s
+}
+
+// Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
+func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.One()
+	}
+
+	sc := assert(scalar)
+
+	if sc.IsZero() {
+		return s.One()
+	}
+
+	if s.IsZero() {
+		return s
+	}
+
+	scalarField.Exponent(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it. It exponentiates by order - 2, so the
+// inverse of 0 is 0.
+func (s *Scalar) Invert() internal.Scalar {
+	scalarField.Inv(&s.scalar, &s.scalar)
+	return s
+}
+
+// Equal returns 1 if the scalars are equal, and 0 otherwise.
+func (s *Scalar) Equal(scalar internal.Scalar) int {
+	if scalar == nil {
+		return 0
+	}
+
+	sc := assert(scalar)
+
+	return subtle.ConstantTimeCompare(s.Encode(), sc.Encode())
+}
+
+// LessOrEqual returns 1 if s <= scalar, and 0 otherwise.
+func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
+	sc := assert(scalar)
+	if s.scalar.Cmp(&sc.scalar) <= 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := assert(scalar)
+	return internal.CompareBigEndian(s.Encode(), sc.Encode())
+}
+
+// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return internal.BitBigEndian(s.Encode(), i)
+}
+
+// IsZero returns whether the scalar is 0.
+func (s *Scalar) IsZero() bool {
+	return scalarField.IsZero(&s.scalar)
+}
+
+// Set sets the receiver to the value of the argument scalar, and returns the receiver.
+func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.Zero()
+	}
+
+	sc := assert(scalar)
+	s.scalar.Set(&sc.scalar)
+
+	return s
+}
+
+// SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
+func (s *Scalar) SetUInt64(i uint64) internal.Scalar {
+	s.scalar.SetUint64(i)
+	return s
+}
+
+// UInt64 returns the uint64 representation of the scalar,
+// or an error if its value is higher than the authorized limit for uint64.
+func (s *Scalar) UInt64() (uint64, error) {
+	if !s.scalar.IsUint64() {
+		return 0, internal.ErrUInt64TooBig
+	}
+
+	return binary.BigEndian.Uint64(s.Encode()[scalarLength-8:]), nil
+}
+
+// Copy returns a copy of the receiver.
+func (s *Scalar) Copy() internal.Scalar {
+	cpy := newScalar()
+	cpy.scalar.Set(&s.scalar)
+
+	return cpy
+}
+
+// Encode returns the fixed-length big-endian encoding of the scalar.
+func (s *Scalar) Encode() []byte {
+	return s.scalar.FillBytes(make([]byte, scalarLength))
+}
+
+// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetBytesReduced(in []byte) internal.Scalar {
+	s.scalar.Set(scalarField.Mod(new(big.Int).SetBytes(in)))
+	return s
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+func (s *Scalar) Decode(in []byte) error {
+	switch len(in) {
+	case 0:
+		return internal.ErrParamNilScalar
+	case scalarLength:
+		break
+	default:
+		return internal.ErrParamScalarLength
+	}
+
+	tmp := new(big.Int).SetBytes(in)
+	if tmp.Cmp(&groupOrder) >= 0 {
+		return internal.ErrParamScalarInvalidEncoding
+	}
+
+	s.scalar.Set(tmp)
+
+	return nil
+}
+
+// Hex returns the fixed-sized hexadecimal encoding of s.
+func (s *Scalar) Hex() string {
+	return hex.EncodeToString(s.Encode())
+}
+
+// DecodeHex sets s to the decoding of the hex encoded scalar.
+func (s *Scalar) DecodeHex(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return s.Decode(b)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package curve448 implements the big.Int based field, point, and scalar arithmetic of the edwards448 curve, shared by
+// the Decaf448 and Edwards448 groups. It is not constant time.
+package curve448
+
+import (
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+var (
+	// Fp is the base field of edwards448, of order p = 2^448 - 2^224 - 1.
+	Fp = field.NewField(new(big.Int).Sub(
+		new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 448), new(big.Int).Lsh(big.NewInt(1), 224)),
+		big.NewInt(1)))
+
+	// D is the d parameter of edwards448, x^2 + y^2 = 1 + d * x^2 * y^2, with d = -39081.
+	D = new(big.Int).Sub(Fp.Order(), big.NewInt(39081))
+)
+
+// Mul returns x * y mod p.
+func Mul(x, y *big.Int) *big.Int {
+	return Fp.Mod(new(big.Int).Mul(x, y))
+}
+
+// Add returns x + y mod p.
+func Add(x, y *big.Int) *big.Int {
+	return Fp.Mod(new(big.Int).Add(x, y))
+}
+
+// Sub returns x - y mod p.
+func Sub(x, y *big.Int) *big.Int {
+	return Fp.Mod(new(big.Int).Sub(x, y))
+}
+
+// Neg returns -x mod p.
+func Neg(x *big.Int) *big.Int {
+	return Fp.Mod(new(big.Int).Neg(x))
+}
+
+// Invert returns 1 / x mod p, and 0 if x is 0.
+func Invert(x *big.Int) *big.Int {
+	res := new(big.Int)
+	Fp.Inv(res, x)
+
+	return res
+}
+
+// Point is a point of edwards448 in extended coordinates, with x = X/Z, y = Y/Z, and x * y = T/Z.
+type Point struct {
+	X, Y, Z, T *big.Int
+}
+
+// Identity returns a new point set to the identity (0, 1).
+func Identity() *Point {
+	return &Point{X: new(big.Int), Y: big.NewInt(1), Z: big.NewInt(1), T: new(big.Int)}
+}
+
+// NewAffinePoint returns a new point with the affine coordinates (x, y).
+func NewAffinePoint(x, y *big.Int) *Point {
+	return &Point{X: x, Y: y, Z: big.NewInt(1), T: Mul(x, y)}
+}
+
+// Copy returns a copy of p.
+func (p *Point) Copy() *Point {
+	return &Point{
+		X: new(big.Int).Set(p.X),
+		Y: new(big.Int).Set(p.Y),
+		Z: new(big.Int).Set(p.Z),
+		T: new(big.Int).Set(p.T),
+	}
+}
+
+// Add returns p + q, using the unified addition formulas for a = 1 twisted Edwards curves, which are complete since d
+// is not a square.
+func (p *Point) Add(q *Point) *Point {
+	a := Mul(p.X, q.X)
+	b := Mul(p.Y, q.Y)
+	c := Mul(D, Mul(p.T, q.T))
+	d := Mul(p.Z, q.Z)
+	e := Sub(Sub(Mul(Add(p.X, p.Y), Add(q.X, q.Y)), a), b)
+	f := Sub(d, c)
+	g := Add(d, c)
+	h := Sub(b, a)
+
+	return &Point{X: Mul(e, f), Y: Mul(g, h), Z: Mul(f, g), T: Mul(e, h)}
+}
+
+// Double returns 2 * p.
+func (p *Point) Double() *Point {
+	a := Mul(p.X, p.X)
+	b := Mul(p.Y, p.Y)
+	c := Mul(big.NewInt(2), Mul(p.Z, p.Z))
+	xy := Add(p.X, p.Y)
+	e := Sub(Sub(Mul(xy, xy), a), b)
+	g := Add(a, b)
+	f := Sub(g, c)
+	h := Sub(a, b)
+
+	return &Point{X: Mul(e, f), Y: Mul(g, h), Z: Mul(f, g), T: Mul(e, h)}
+}
+
+// Negate returns -p.
+func (p *Point) Negate() *Point {
+	return &Point{X: Neg(p.X), Y: new(big.Int).Set(p.Y), Z: new(big.Int).Set(p.Z), T: Neg(p.T)}
+}
+
+// Equal returns whether p and q are the same point, i.e. whether X1 * Z2 == X2 * Z1 and Y1 * Z2 == Y2 * Z1.
+func (p *Point) Equal(q *Point) bool {
+	return Mul(p.X, q.Z).Cmp(Mul(q.X, p.Z)) == 0 && Mul(p.Y, q.Z).Cmp(Mul(q.Y, p.Z)) == 0
+}
+
+// Affine returns the affine coordinates of p.
+func (p *Point) Affine() (x, y *big.Int) {
+	zInv := Invert(p.Z)
+	return Mul(p.X, zInv), Mul(p.Y, zInv)
+}
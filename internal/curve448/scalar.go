@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package curve448 implements the big.Int based field, point, and scalar arithmetic of the edwards448 curve, shared by
+// the Decaf448 and Edwards448 groups. It is not constant time.
+package curve448
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+var (
+	// Order is the prime order of the edwards448 subgroup, and of the Decaf448 group,
+	// 2^446 - 13818066809895115352007386748515426880336692474882178609894547503885.
+	Order = field.String2Int("0x3fffffffffffffffffffffffffffffffffffffffffffffffffffffff7cca23e9c44edb49aed63690216cc2728dc58f552378c292ab5844f3")
+
+	scalarField = field.NewField(&Order)
+)
+
+// OrderBytes returns the little-endian encoding of the group order, of the given length.
+func OrderBytes(length int) []byte {
+	return internal.Reverse(Order.FillBytes(make([]byte, length)))
+}
+
+// Scalar implements the Scalar interface for scalars modulo the edwards448 subgroup order, with little-endian
+// encodings of a fixed length.
+type Scalar struct {
+	scalar big.Int
+	group  byte
+	length int
+}
+
+// NewScalar returns a new scalar set to 0, for the group with the given identifier and scalar encoding length.
+func NewScalar(group byte, length int) *Scalar {
+	return &Scalar{group: group, length: length}
+}
+
+// Assert returns the scalar as a *Scalar, and panics if it is not a scalar of the same group as s.
+func (s *Scalar) Assert(scalar internal.Scalar) *Scalar {
+	sc, ok := scalar.(*Scalar)
+	if !ok || sc.group != s.group {
+		panic(internal.ErrCastScalar)
+	}
+
+	return sc
+}
+
+// Group returns the group's Identifier.
+func (s *Scalar) Group() byte {
+	return s.group
+}
+
+// Zero sets the scalar to 0, and returns it.
+func (s *Scalar) Zero() internal.Scalar {
+	s.scalar.SetUint64(0)
+	return s
+}
+
+// One sets the scalar to 1, and returns it.
+func (s *Scalar) One() internal.Scalar {
+	s.scalar.SetUint64(1)
+	return s
+}
+
+// MinusOne sets the scalar to order-1, and returns it.
+func (s *Scalar) MinusOne() internal.Scalar {
+	s.scalar.Set(scalarField.PMinusOne())
+	return s
+}
+
+// Random sets the current scalar to a new random scalar and returns it.
+// The random source is crypto/rand, and this functions is guaranteed to return a non-zero scalar.
+func (s *Scalar) Random() internal.Scalar {
+	for {
+		scalarField.Random(&s.scalar)
+
+		if !s.IsZero() {
+			return s
+		}
+	}
+}
+
+// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
+func (s *Scalar) Add(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s
+	}
+
+	sc := s.Assert(scalar)
+	scalarField.Add(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// Subtract subtracts the input from the receiver, and returns the receiver.
+func (s *Scalar) Subtract(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s
+	}
+
+	sc := s.Assert(scalar)
+	scalarField.Sub(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// Multiply multiplies the receiver with the input, and returns the receiver.
+func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.Zero()
+	}
+
+	sc := s.Assert(scalar)
+	scalarField.Mul(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+func (s *Scalar) MultiplyAdd(a, b internal.Scalar) internal.Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	sa := s.Assert(a)
+	sb := s.Assert(b)
+
+	// Use an intermediate value in case b aliases the receiver.
+	var product big.Int
+	product.Mul(&s.scalar, &sa.scalar)
+	scalarField.Add(&s.scalar, &product, &sb.scalar)
+
+	return s
+}
+
+// Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
+func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.One()
+	}
+
+	sc := s.Assert(scalar)
+
+	if sc.IsZero() {
+		return s.One()
+	}
+
+	if s.IsZero() {
+		return s
+	}
+
+	scalarField.Exponent(&s.scalar, &s.scalar, &sc.scalar)
+
+	return s
+}
+
+// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it. It exponentiates by order - 2, so the
+// inverse of 0 is 0.
+func (s *Scalar) Invert() internal.Scalar {
+	scalarField.Inv(&s.scalar, &s.scalar)
+	return s
+}
+
+// Equal returns 1 if the scalars are equal, and 0 otherwise.
+func (s *Scalar) Equal(scalar internal.Scalar) int {
+	if scalar == nil {
+		return 0
+	}
+
+	sc := s.Assert(scalar)
+
+	return subtle.ConstantTimeCompare(s.Encode(), sc.Encode())
+}
+
+// LessOrEqual returns 1 if s <= scalar, and 0 otherwise.
+func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
+	sc := s.Assert(scalar)
+	if s.scalar.Cmp(&sc.scalar) <= 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := s.Assert(scalar)
+	return internal.CompareLittleEndian(s.Encode(), sc.Encode())
+}
+
+// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return internal.BitLittleEndian(s.Encode(), i)
+}
+
+// IsZero returns whether the scalar is 0.
+func (s *Scalar) IsZero() bool {
+	return scalarField.IsZero(&s.scalar)
+}
+
+// Set sets the receiver to the value of the argument scalar, and returns the receiver.
+func (s *Scalar) Set(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.Zero()
+	}
+
+	sc := s.Assert(scalar)
+	s.scalar.Set(&sc.scalar)
+
+	return s
+}
+
+// SetUInt64 sets s to i modulo the field order, and returns an error if one occurs.
+func (s *Scalar) SetUInt64(i uint64) internal.Scalar {
+	s.scalar.SetUint64(i)
+	return s
+}
+
+// UInt64 returns the uint64 representation of the scalar,
+// or an error if its value is higher than the authorized limit for uint64.
+func (s *Scalar) UInt64() (uint64, error) {
+	if !s.scalar.IsUint64() {
+		return 0, internal.ErrUInt64TooBig
+	}
+
+	return binary.LittleEndian.Uint64(s.Encode()[:8]), nil
+}
+
+// Copy returns a copy of the receiver.
+func (s *Scalar) Copy() internal.Scalar {
+	cpy := NewScalar(s.group, s.length)
+	cpy.scalar.Set(&s.scalar)
+
+	return cpy
+}
+
+// Encode returns the fixed-length little-endian encoding of the scalar.
+func (s *Scalar) Encode() []byte {
+	return internal.Reverse(s.scalar.FillBytes(make([]byte, s.length)))
+}
+
+// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetBytesReduced(in []byte) internal.Scalar {
+	s.scalar.Set(scalarField.Mod(new(big.Int).SetBytes(in)))
+	return s
+}
+
+// SetUniform sets s to the little-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetUniform(in []byte) *Scalar {
+	s.SetBytesReduced(internal.Reverse(in))
+	return s
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+func (s *Scalar) Decode(in []byte) error {
+	switch len(in) {
+	case 0:
+		return internal.ErrParamNilScalar
+	case s.length:
+		break
+	default:
+		return internal.ErrParamScalarLength
+	}
+
+	tmp := new(big.Int).SetBytes(internal.Reverse(in))
+	if tmp.Cmp(&Order) >= 0 {
+		return internal.ErrParamScalarInvalidEncoding
+	}
+
+	s.scalar.Set(tmp)
+
+	return nil
+}
+
+// Hex returns the fixed-sized hexadecimal encoding of s.
+func (s *Scalar) Hex() string {
+	return hex.EncodeToString(s.Encode())
+}
+
+// DecodeHex sets s to the decoding of the hex encoded scalar.
+func (s *Scalar) DecodeHex(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return s.Decode(b)
+}
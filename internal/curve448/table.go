@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package curve448 implements the big.Int based field, point, and scalar arithmetic of the edwards448 curve, shared by
+// the Decaf448 and Edwards448 groups. It is not constant time.
+package curve448
+
+import "sync"
+
+// window is the width, in bits, of the scalar digits in scalar multiplications.
+const window = 4
+
+// digit returns the i-th window-bit digit of the little-endian encoded scalar.
+func digit(s []byte, i int) byte {
+	return (s[i/2] >> (window * (i % 2))) & (1<<window - 1)
+}
+
+// ScalarMult returns s * p, with a fixed window over the little-endian encoded scalar.
+func ScalarMult(s []byte, p *Point) *Point {
+	// table[d] = d * p, for 0 <= d < 2^window.
+	table := make([]*Point, 1<<window)
+	table[0] = Identity()
+
+	for d := 1; d < len(table); d++ {
+		table[d] = table[d-1].Add(p)
+	}
+
+	result := Identity()
+
+	for i := 2*len(s) - 1; i >= 0; i-- {
+		for range window {
+			result = result.Double()
+		}
+
+		result = result.Add(table[digit(s, i)])
+	}
+
+	return result
+}
+
+// Table holds the multiples of a fixed point, computed on first use, for faster multiplications of that point.
+type Table struct {
+	base *Point
+	once sync.Once
+
+	// rows[i][d] = d * 2^(window*i) * base.
+	rows [][]*Point
+}
+
+// NewTable returns a new Table for the point and scalars of the given byte length.
+func NewTable(base *Point, scalarLength int) *Table {
+	return &Table{
+		base: base,
+		rows: make([][]*Point, 8*scalarLength/window),
+	}
+}
+
+func (t *Table) init() {
+	b := t.base.Copy()
+
+	for i := range t.rows {
+		row := make([]*Point, 1<<window)
+		row[0] = Identity()
+
+		for d := 1; d < len(row); d++ {
+			row[d] = row[d-1].Add(b)
+		}
+
+		t.rows[i] = row
+
+		for range window {
+			b = b.Double()
+		}
+	}
+}
+
+// Multiply returns s * base for the little-endian encoded scalar, as the sum of one precomputed multiple of the base
+// per digit of the scalar, which avoids all doublings.
+func (t *Table) Multiply(s []byte) *Point {
+	t.once.Do(t.init)
+
+	result := Identity()
+	for i, row := range t.rows {
+		result = result.Add(row[digit(s, i)])
+	}
+
+	return result
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package decaf448 allows simple and abstracted operations in the Decaf448 group.
+package decaf448
+
+import (
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/curve448"
+)
+
+// Shorthands for the field arithmetic.
+var (
+	mul = curve448.Mul
+	add = curve448.Add
+	sub = curve448.Sub
+	neg = curve448.Neg
+)
+
+var (
+	one = big.NewInt(1)
+
+	oneMinusD    = big.NewInt(39082)
+	oneMinusTwoD = big.NewInt(78163)
+
+	// sqrtRatioExponent = (p - 3) / 4.
+	sqrtRatioExponent = new(big.Int).Rsh(new(big.Int).Sub(curve448.Fp.Order(), big.NewInt(3)), 2)
+
+	// sqrtMinusD = CT_ABS(sqrt(-d)) = CT_ABS((-d)^((p + 1) / 4)), since p = 3 mod 4, and invSqrtMinusD = 1 / sqrtMinusD.
+	sqrtMinusD = ctAbs(curve448.Fp.Exponent(new(big.Int), big.NewInt(39081),
+		new(big.Int).Rsh(new(big.Int).Add(curve448.Fp.Order(), big.NewInt(1)), 2)))
+	invSqrtMinusD = curve448.Invert(sqrtMinusD)
+)
+
+// isNegative returns whether the reduced field element is negative, i.e. odd, as defined in RFC 9496.
+func isNegative(x *big.Int) bool {
+	return x.Bit(0) == 1
+}
+
+// ctAbs returns the non-negative one of x and -x. Despite the RFC 9496 name, it is not constant time.
+func ctAbs(x *big.Int) *big.Int {
+	if isNegative(x) {
+		return neg(x)
+	}
+
+	return x
+}
+
+// sqrtRatioM1 returns whether u / v is a square, and CT_ABS(sqrt(u / v)) if it is, as specified in RFC 9496.
+func sqrtRatioM1(u, v *big.Int) (bool, *big.Int) {
+	r := mul(u, curve448.Fp.Exponent(new(big.Int), mul(u, v), sqrtRatioExponent))
+	check := mul(v, mul(r, r))
+
+	return check.Cmp(u) == 0, ctAbs(r)
+}
+
+// equal returns whether p and q are in the same decaf448 equivalence class, i.e. whether x1 * y2 == y1 * x2.
+func equal(p, q *curve448.Point) bool {
+	return mul(p.X, q.Y).Cmp(mul(p.Y, q.X)) == 0
+}
+
+// encode returns the RFC 9496 encoding of p.
+func encode(p *curve448.Point) []byte {
+	u1 := mul(add(p.X, p.T), sub(p.X, p.T))
+	_, invsqrt := sqrtRatioM1(one, mul(mul(u1, oneMinusD), mul(p.X, p.X)))
+	ratio := ctAbs(mul(mul(invsqrt, u1), sqrtMinusD))
+	u2 := sub(mul(mul(invSqrtMinusD, ratio), p.Z), p.T)
+	s := ctAbs(mul(mul(mul(oneMinusD, invsqrt), p.X), u2))
+
+	out := make([]byte, canonicalEncodingLength)
+	s.FillBytes(out)
+
+	return internal.Reverse(out)
+}
+
+// decode returns the point encoded by the RFC 9496 encoding, and false if the encoding is invalid.
+func decode(data []byte) (*curve448.Point, bool) {
+	s := new(big.Int).SetBytes(internal.Reverse(data))
+	if s.Cmp(curve448.Fp.Order()) >= 0 || isNegative(s) {
+		return nil, false
+	}
+
+	ss := mul(s, s)
+	u1 := add(one, ss)
+	u2 := sub(mul(u1, u1), mul(mul(big.NewInt(4), curve448.D), ss))
+
+	wasSquare, invsqrt := sqrtRatioM1(one, mul(u2, mul(u1, u1)))
+	if !wasSquare {
+		return nil, false
+	}
+
+	u3 := ctAbs(mul(mul(mul(mul(big.NewInt(2), s), invsqrt), u1), sqrtMinusD))
+	x := mul(mul(mul(u3, invsqrt), u2), invSqrtMinusD)
+	y := mul(mul(sub(one, ss), invsqrt), u1)
+
+	return curve448.NewAffinePoint(x, y), true
+}
+
+// elligator returns the image of the field element t by the RFC 9496 one-way map.
+func elligator(t *big.Int) *curve448.Point {
+	r := neg(mul(t, t))
+	u0 := mul(curve448.D, sub(r, one))
+	u1 := mul(add(u0, one), sub(u0, r))
+
+	rPlusOne := add(r, one)
+	wasSquare, v := sqrtRatioM1(oneMinusTwoD, mul(rPlusOne, u1))
+
+	sgn := one
+	if !wasSquare {
+		v = mul(t, v)
+		sgn = neg(one)
+	}
+
+	s := mul(v, rPlusOne)
+	ss := mul(s, s)
+	w0 := mul(big.NewInt(2), ctAbs(s))
+	w1 := add(ss, one)
+	w2 := sub(ss, one)
+	w3 := add(mul(mul(mul(v, s), sub(r, one)), oneMinusTwoD), sgn)
+
+	return &curve448.Point{X: mul(w0, w3), Y: mul(w2, w1), Z: mul(w1, w3), T: mul(w0, w2)}
+}
+
+// deriveElement returns the element derived from 112 uniform bytes, as specified in RFC 9496.
+func deriveElement(uniform []byte) *curve448.Point {
+	half := len(uniform) / 2
+	t0 := curve448.Fp.Mod(new(big.Int).SetBytes(internal.Reverse(uniform[:half])))
+	t1 := curve448.Fp.Mod(new(big.Int).SetBytes(internal.Reverse(uniform[half:])))
+
+	return elligator(t0).Add(elligator(t1))
+}
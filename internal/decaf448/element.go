@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package decaf448 allows simple and abstracted operations in the Decaf448 group.
+package decaf448
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/curve448"
+)
+
+// Element implements the Element interface for the Decaf448 group element.
+type Element struct {
+	point *curve448.Point
+}
+
+func newElement() *Element {
+	return &Element{point: curve448.Identity()}
+}
+
+func checkElement(element internal.Element) *Element {
+	if element == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	ec, ok := element.(*Element)
+	if !ok {
+		panic(internal.ErrCastElement)
+	}
+
+	return ec
+}
+
+// Group returns the group's Identifier.
+func (e *Element) Group() byte {
+	return Identifier
+}
+
+// Base sets the element to the group's base point a.k.a. canonical generator.
+func (e *Element) Base() internal.Element {
+	e.point = base.Copy()
+	return e
+}
+
+// Identity sets the element to the identity element of the group.
+func (e *Element) Identity() internal.Element {
+	e.point = curve448.Identity()
+	return e
+}
+
+// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
+func (e *Element) Add(element internal.Element) internal.Element {
+	ec := checkElement(element)
+	e.point = e.point.Add(ec.point)
+
+	return e
+}
+
+// Double sets the receiver to its double, and returns it.
+func (e *Element) Double() internal.Element {
+	e.point = e.point.Double()
+	return e
+}
+
+// Negate sets the receiver to its negation, and returns it.
+func (e *Element) Negate() internal.Element {
+	e.point = e.point.Negate()
+	return e
+}
+
+// Subtract subtracts the input from the receiver, and returns the receiver.
+func (e *Element) Subtract(element internal.Element) internal.Element {
+	ec := checkElement(element)
+	e.point = e.point.Add(ec.point.Negate())
+
+	return e
+}
+
+// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
+func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
+	if scalar == nil {
+		return e.Identity()
+	}
+
+	sc := curve448.NewScalar(Identifier, canonicalEncodingLength).Assert(scalar)
+	e.point = curve448.ScalarMult(sc.Encode(), e.point)
+
+	return e
+}
+
+// Equal returns 1 if the elements are equivalent, and 0 otherwise.
+func (e *Element) Equal(element internal.Element) int {
+	ec := checkElement(element)
+	if equal(e.point, ec.point) {
+		return 1
+	}
+
+	return 0
+}
+
+// ClearCofactor sets the receiver to its product with the group's cofactor, and returns it. Decaf448 is a prime-order
+// group abstracting away the cofactor of the underlying curve, so this is a no-op.
+func (e *Element) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order group. Decaf448 elements are
+// always in the prime-order group, so any element other than the identity is valid.
+func (e *Element) IsValid() bool {
+	return !e.IsIdentity()
+}
+
+// IsIdentity returns whether the Element is the identity element of the group.
+func (e *Element) IsIdentity() bool {
+	return equal(e.point, curve448.Identity())
+}
+
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it. The selection
+// is done on the fixed-size encodings of the coordinates without branching on choice, but the big.Int based arithmetic
+// of this backend is not constant time.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := checkElement(element)
+	dst := []*big.Int{e.point.X, e.point.Y, e.point.Z, e.point.T}
+	src := []*big.Int{ec.point.X, ec.point.Y, ec.point.Z, ec.point.T}
+	p := make([]*big.Int, len(dst))
+
+	for i := range dst {
+		coordinate := dst[i].FillBytes(make([]byte, canonicalEncodingLength))
+		subtle.ConstantTimeCopy(choice, coordinate, src[i].FillBytes(make([]byte, canonicalEncodingLength)))
+		p[i] = new(big.Int).SetBytes(coordinate)
+	}
+
+	e.point = &curve448.Point{X: p[0], Y: p[1], Z: p[2], T: p[3]}
+
+	return e
+}
+
+// Set sets the receiver to the value of the argument, and returns the receiver.
+func (e *Element) Set(element internal.Element) internal.Element {
+	if element == nil {
+		return e.Identity()
+	}
+
+	ec := checkElement(element)
+	e.point = ec.point.Copy()
+
+	return e
+}
+
+// Copy returns a copy of the receiver.
+func (e *Element) Copy() internal.Element {
+	return &Element{point: e.point.Copy()}
+}
+
+// Encode returns the compressed byte encoding of the element.
+func (e *Element) Encode() []byte {
+	return encode(e.point)
+}
+
+// YCoordinate returns nil, as Decaf448 elements are equivalence classes of points and have no coordinates.
+func (e *Element) YCoordinate() []byte {
+	return nil
+}
+
+// XCoordinate returns the encoded x coordinate of the element, which is the same as Encode().
+func (e *Element) XCoordinate() []byte {
+	return e.Encode()
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+func (e *Element) Decode(data []byte) error {
+	if len(data) != canonicalEncodingLength {
+		return fmt.Errorf("invalid Decaf448 encoding: %w", internal.ErrParamInvalidPointEncoding)
+	}
+
+	p, ok := decode(data)
+	if !ok {
+		return fmt.Errorf("invalid Decaf448 encoding: %w", internal.ErrParamInvalidPointEncoding)
+	}
+
+	if equal(p, curve448.Identity()) {
+		return fmt.Errorf("invalid Decaf448 encoding: %w", internal.ErrIdentity)
+	}
+
+	e.point = p
+
+	return nil
+}
+
+// Hex returns the fixed-sized hexadecimal encoding of e.
+func (e *Element) Hex() string {
+	return hex.EncodeToString(e.Encode())
+}
+
+// DecodeHex sets e to the decoding of the hex encoded element.
+func (e *Element) DecodeHex(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return e.Decode(b)
+}
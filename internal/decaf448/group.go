@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package decaf448 allows simple and abstracted operations in the Decaf448 group. It relies on the variable-time
+// arithmetic of the curve448 package, and must not be used with secret scalars where timing side channels matter.
+package decaf448
+
+import (
+	"crypto"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/0xBridge/hash2curve"
+	"github.com/bytemare/hash"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/curve448"
+)
+
+const (
+	// Identifier distinguishes this group from the others by a byte representation.
+	Identifier = byte(2)
+
+	// H2C represents the hash-to-curve string identifier.
+	H2C = "decaf448_XOF:SHAKE256_D448MAP_RO_"
+
+	// elementInputLength is the length of the uniform input to element derivation, as specified in RFC 9496.
+	elementInputLength = 112
+
+	// scalarInputLength is the length of the uniform input to scalar derivation, as specified in RFC 9496.
+	scalarInputLength = 64
+
+	// fieldSecLength is the expansion length L of hash_to_field, for a security level of k = 224 bits.
+	fieldSecLength = 84
+
+	// canonicalEncodingLength is the byte size of encoded scalars and elements.
+	canonicalEncodingLength = 56
+)
+
+var (
+	base = mustDecode("6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333")
+
+	// baseTable holds precomputed multiples of the base point.
+	baseTable = curve448.NewTable(base, canonicalEncodingLength)
+)
+
+func mustDecode(h string) *curve448.Point {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		panic(err)
+	}
+
+	p, ok := decode(b)
+	if !ok {
+		panic(internal.ErrParamInvalidPointEncoding)
+	}
+
+	return p
+}
+
+// Group represents the Decaf448 group. It exposes a prime-order group API with hash-to-curve operations. This
+// implementation relies on big.Int arithmetic, and is therefore not constant time.
+type Group struct{}
+
+// New returns a new instantiation of the Decaf448 Group.
+func New() internal.Group {
+	return Group{}
+}
+
+// NewScalar returns a new scalar set to 0.
+func (g Group) NewScalar() internal.Scalar {
+	return curve448.NewScalar(Identifier, canonicalEncodingLength)
+}
+
+// NewElement returns the identity element.
+func (g Group) NewElement() internal.Element {
+	return newElement()
+}
+
+// Base returns the group's base point a.k.a. canonical generator.
+func (g Group) Base() internal.Element {
+	return newElement().Base()
+}
+
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar, as the sum of one
+// precomputed multiple of the base point per digit of the scalar, which avoids all doublings.
+func (g Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	sc := curve448.NewScalar(Identifier, canonicalEncodingLength).Assert(scalar)
+	return &Element{point: baseTable.Multiply(sc.Encode())}
+}
+
+// HashFunc returns SHA2-512. The RFC9380 hash-to-curve suite of Decaf448 uses the SHAKE256 extendable output function,
+// which has no crypto.Hash identifier, so SHA2-512 is used where a fixed output hash function is needed, e.g. in HKDF.
+func (g Group) HashFunc() crypto.Hash {
+	return crypto.SHA512
+}
+
+// HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
+	return g.ScalarFromUniform(hash2curve.ExpandXOF(hash.SHAKE256.GetXOF(), input, dst, scalarInputLength))
+}
+
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	uniform := hash2curve.ExpandXOF(hash.SHAKE256.GetXOF(), input, dst, count*scalarInputLength)
+	out := make([]internal.Scalar, count)
+
+	for i := range out {
+		chunk := uniform[i*scalarInputLength : (i+1)*scalarInputLength]
+		out[i] = curve448.NewScalar(Identifier, canonicalEncodingLength).SetUniform(chunk)
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite. Decaf448 doesn't
+// use hash_to_field to map to the group, so this uses those of edwards448_XOF:SHAKE256_ELL2_RO_.
+func (g Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hash2curve.HashToFieldXOF(hash.SHAKE256.GetXOF(), input, dst, count, 1, fieldSecLength, curve448.Fp.Order())
+}
+
+// HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToGroup(input, dst []byte) internal.Element {
+	return g.ElementFromUniform(hash2curve.ExpandXOF(hash.SHAKE256.GetXOF(), input, dst, elementInputLength))
+}
+
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g Group) UniformLengths() (element, scalar uint) {
+	return elementInputLength, scalarInputLength
+}
+
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g Group) ElementFromUniform(uniform []byte) internal.Element {
+	return &Element{point: deriveElement(uniform)}
+}
+
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g Group) ScalarFromUniform(uniform []byte) internal.Scalar {
+	return curve448.NewScalar(Identifier, canonicalEncodingLength).SetUniform(uniform)
+}
+
+// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
+	return g.HashToGroup(input, dst)
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier.
+func (g Group) Ciphersuite() string {
+	return H2C
+}
+
+// ScalarLength returns the byte size of an encoded scalar.
+func (g Group) ScalarLength() int {
+	return canonicalEncodingLength
+}
+
+// ElementLength returns the byte size of an encoded element.
+func (g Group) ElementLength() int {
+	return canonicalEncodingLength
+}
+
+// Order returns the order of the canonical group of scalars.
+func (g Group) Order() []byte {
+	return curve448.OrderBytes(canonicalEncodingLength)
+}
+
+// FieldPrime returns the big-endian encoding of the order of the base field of edwards448, p = 2^448 - 2^224 - 1, over
+// which Decaf448 elements are encoded.
+func (g Group) FieldPrime() []byte {
+	return curve448.Fp.Order().FillBytes(make([]byte, canonicalEncodingLength))
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar. Decaf448 is a prime-order group, so it's 1.
+func (g Group) Cofactor() []byte {
+	out := make([]byte, canonicalEncodingLength)
+	out[0] = 1
+
+	return out
+}
@@ -0,0 +1,210 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package der implements the DER encoding of public keys in SubjectPublicKeyInfo structures, as specified in RFC 5280,
+// and of elliptic curve private keys in PKCS #8 (RFC 5208) and SEC1 (RFC 5915) structures.
+package der
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+)
+
+var (
+	// OIDPublicKeyEC is id-ecPublicKey, the algorithm of elliptic curve public keys (RFC 5480).
+	OIDPublicKeyEC = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+
+	// OIDPublicKeyEd25519 is id-Ed25519 (RFC 8410).
+	OIDPublicKeyEd25519 = asn1.ObjectIdentifier{1, 3, 101, 112}
+
+	// OIDPublicKeyEd448 is id-Ed448 (RFC 8410).
+	OIDPublicKeyEd448 = asn1.ObjectIdentifier{1, 3, 101, 113}
+
+	// OIDNamedCurveP224 is secp224r1 (RFC 5480).
+	OIDNamedCurveP224 = asn1.ObjectIdentifier{1, 3, 132, 0, 33}
+
+	// OIDNamedCurveP256 is secp256r1 (RFC 5480).
+	OIDNamedCurveP256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+
+	// OIDNamedCurveP384 is secp384r1 (RFC 5480).
+	OIDNamedCurveP384 = asn1.ObjectIdentifier{1, 3, 132, 0, 34}
+
+	// OIDNamedCurveP521 is secp521r1 (RFC 5480).
+	OIDNamedCurveP521 = asn1.ObjectIdentifier{1, 3, 132, 0, 35}
+
+	// OIDNamedCurveSecp256k1 is secp256k1 (SEC 2).
+	OIDNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+	// ErrInvalidEncoding indicates a malformed DER encoding.
+	ErrInvalidEncoding = errors.New("invalid DER encoding")
+)
+
+// Algorithm identifies the algorithm of a key, and for elliptic curve keys the curve it's on. NamedCurve is nil for the
+// algorithms without parameters, like Ed25519 and Ed448.
+type Algorithm struct {
+	OID        asn1.ObjectIdentifier
+	NamedCurve asn1.ObjectIdentifier
+}
+
+// Equal returns whether a and b identify the same algorithm and curve.
+func (a Algorithm) Equal(b Algorithm) bool {
+	return a.OID.Equal(b.OID) && a.NamedCurve.Equal(b.NamedCurve)
+}
+
+// ecPrivateKeyVersion is the version of ECPrivateKey structures.
+const ecPrivateKeyVersion = 1
+
+type ecPrivateKey struct {
+	Version    int
+	PrivateKey []byte
+	NamedCurve asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey  asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+type privateKeyInfo struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+func (a Algorithm) identifier() (pkix.AlgorithmIdentifier, error) {
+	id := pkix.AlgorithmIdentifier{Algorithm: a.OID}
+	if a.NamedCurve == nil {
+		return id, nil
+	}
+
+	params, err := asn1.Marshal(a.NamedCurve)
+	if err != nil {
+		return id, err
+	}
+
+	id.Parameters.FullBytes = params
+
+	return id, nil
+}
+
+func parseAlgorithm(id pkix.AlgorithmIdentifier) (Algorithm, error) {
+	a := Algorithm{OID: id.Algorithm}
+	if len(id.Parameters.FullBytes) == 0 {
+		return a, nil
+	}
+
+	rest, err := asn1.Unmarshal(id.Parameters.FullBytes, &a.NamedCurve)
+	if err != nil || len(rest) != 0 {
+		return a, ErrInvalidEncoding
+	}
+
+	return a, nil
+}
+
+// MarshalPublicKey returns the DER encoding of the SubjectPublicKeyInfo holding key for the algorithm.
+func MarshalPublicKey(a Algorithm, key []byte) ([]byte, error) {
+	id, err := a.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: id,
+		PublicKey: asn1.BitString{Bytes: key, BitLength: 8 * len(key)},
+	})
+}
+
+// ParsePublicKey returns the algorithm and the key held by the DER encoded SubjectPublicKeyInfo structure.
+func ParsePublicKey(data []byte) (Algorithm, []byte, error) {
+	var spki subjectPublicKeyInfo
+
+	rest, err := asn1.Unmarshal(data, &spki)
+	if err != nil || len(rest) != 0 || spki.PublicKey.BitLength != 8*len(spki.PublicKey.Bytes) {
+		return Algorithm{}, nil, ErrInvalidEncoding
+	}
+
+	a, err := parseAlgorithm(spki.Algorithm)
+	if err != nil {
+		return Algorithm{}, nil, err
+	}
+
+	return a, spki.PublicKey.Bytes, nil
+}
+
+// MarshalPrivateKey returns the DER encoding of the PKCS #8 PrivateKeyInfo holding the elliptic curve private key for
+// the algorithm, in an ECPrivateKey structure with the encoded public key.
+func MarshalPrivateKey(a Algorithm, key, publicKey []byte) ([]byte, error) {
+	id, err := a.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	inner, err := asn1.Marshal(ecPrivateKey{
+		Version:    ecPrivateKeyVersion,
+		PrivateKey: key,
+		PublicKey:  asn1.BitString{Bytes: publicKey, BitLength: 8 * len(publicKey)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(privateKeyInfo{Algorithm: id, PrivateKey: inner})
+}
+
+// ParsePrivateKey returns the algorithm and the private key held by the DER encoded PKCS #8 PrivateKeyInfo structure.
+// For elliptic curve keys, the key is extracted from its ECPrivateKey structure, and the public key, if any, is ignored.
+// The key is returned as is for the other algorithms.
+func ParsePrivateKey(data []byte) (Algorithm, []byte, error) {
+	var info privateKeyInfo
+
+	rest, err := asn1.Unmarshal(data, &info)
+	if err != nil || len(rest) != 0 || info.Version != 0 {
+		return Algorithm{}, nil, ErrInvalidEncoding
+	}
+
+	a, err := parseAlgorithm(info.Algorithm)
+	if err != nil {
+		return Algorithm{}, nil, err
+	}
+
+	if !a.OID.Equal(OIDPublicKeyEC) {
+		return a, info.PrivateKey, nil
+	}
+
+	// The curve in the inner structure is redundant, and must match the algorithm's if present.
+	curve, key, err := parseECPrivateKey(info.PrivateKey)
+	if err != nil || (curve != nil && !curve.Equal(a.NamedCurve)) {
+		return Algorithm{}, nil, ErrInvalidEncoding
+	}
+
+	return a, key, nil
+}
+
+// ParseECPrivateKey returns the algorithm and the private key held by the DER encoded ECPrivateKey structure, which
+// must name its curve. The public key, if any, is ignored.
+func ParseECPrivateKey(data []byte) (Algorithm, []byte, error) {
+	curve, key, err := parseECPrivateKey(data)
+	if err != nil || curve == nil {
+		return Algorithm{}, nil, ErrInvalidEncoding
+	}
+
+	return Algorithm{OID: OIDPublicKeyEC, NamedCurve: curve}, key, nil
+}
+
+func parseECPrivateKey(data []byte) (asn1.ObjectIdentifier, []byte, error) {
+	var key ecPrivateKey
+
+	rest, err := asn1.Unmarshal(data, &key)
+	if err != nil || len(rest) != 0 || key.Version != ecPrivateKeyVersion {
+		return nil, nil, ErrInvalidEncoding
+	}
+
+	return key.NamedCurve, key.PrivateKey, nil
+}
@@ -13,10 +13,33 @@ import (
 	"fmt"
 
 	ed "filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
 
 	"github.com/0xBridge/ecc/internal"
 )
 
+var (
+	feOne = new(field.Element).One()
+
+	// sqrtMinusA2 is the non-negative square root of -486664 = -(A+2) with A = 486662 the Montgomery curve parameter.
+	sqrtMinusA2 = func() *field.Element {
+		var a field.Element
+		if _, err := a.SetBytes([]byte{
+			0x08, 0x6d, 0x07, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		}); err != nil {
+			panic(err)
+		}
+
+		r, wasSquare := new(field.Element).SqrtRatio(a.Negate(&a), feOne)
+		if wasSquare != 1 {
+			panic("-486664 is not a square")
+		}
+
+		return r
+	}()
+)
+
 // Element implements the Element interface for the Edwards25519 group element.
 type Element struct {
 	element ed.Point
@@ -99,6 +122,26 @@ func (e *Element) Equal(element internal.Element) int {
 	return e.element.Equal(&ec.element)
 }
 
+// ClearCofactor sets the receiver to its product with the cofactor 8, and returns it.
+func (e *Element) ClearCofactor() internal.Element {
+	e.element.MultByCofactor(&e.element)
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order subgroup, i.e. whether it is not
+// the identity and [order]e is the identity. Small-order and mixed-order points, which can be decoded, are invalid.
+func (e *Element) IsValid() bool {
+	if e.IsIdentity() {
+		return false
+	}
+
+	// [order]e = [order-1]e + e
+	q := new(ed.Point).ScalarMult(scalarMinusOne, &e.element)
+	q.Add(q, &e.element)
+
+	return q.Equal(ed.NewIdentityPoint()) == 1
+}
+
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 func (e *Element) IsIdentity() bool {
 	return e.element.Equal(ed.NewIdentityPoint()) == 1
@@ -109,6 +152,25 @@ func (e *Element) set(element *Element) *Element {
 	return e
 }
 
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it, in constant time.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := checkElement(element)
+
+	X1, Y1, Z1, T1 := e.element.ExtendedCoordinates()
+	X2, Y2, Z2, T2 := ec.element.ExtendedCoordinates()
+	X1.Select(X2, X1, choice)
+	Y1.Select(Y2, Y1, choice)
+	Z1.Select(Z2, Z1, choice)
+	T1.Select(T2, T1, choice)
+
+	// The coordinates of either valid point are always valid.
+	if _, err := e.element.SetExtendedCoordinates(X1, Y1, Z1, T1); err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
 // Set sets the receiver to the value of the argument, and returns the receiver.
 func (e *Element) Set(element internal.Element) internal.Element {
 	if element == nil {
@@ -133,12 +195,49 @@ func (e *Element) Encode() []byte {
 	return e.element.Bytes()
 }
 
+// YCoordinate returns the little-endian encoded Montgomery v coordinate of the element, matching the u coordinate
+// returned by XCoordinate, following the birational map of RFC 7748, v = sqrt(-486664) * u / x, using the non-negative
+// square root. It is all zeros for the identity and the point of order 2, for which v is 0.
+func (e *Element) YCoordinate() []byte {
+	var y, x, u, recip field.Element
+
+	X, Y, Z, _ := e.element.ExtendedCoordinates()
+	zInv := new(field.Element).Invert(Z)
+	y.Multiply(Y, zInv)                     // y = Y / Z
+	x.Multiply(X, zInv)                     // x = X / Z
+	recip.Invert(recip.Subtract(feOne, &y)) // r = 1/(1 - y)
+	u.Multiply(u.Add(feOne, &y), &recip)    // u = (1 + y)*r
+	u.Multiply(&u, x.Invert(&x))            // u/x
+	u.Multiply(&u, sqrtMinusA2)             // v = sqrt(-486664) * u/x
+
+	return u.Bytes()
+}
+
 // XCoordinate returns the encoded u coordinate of the element. Note that there's no inverse function for this, and
 // that decoding this output might result in another point.
 func (e *Element) XCoordinate() []byte {
 	return e.element.BytesMontgomery()
 }
 
+// EncodeFromMontgomery returns the encoding of the edwards25519 point with a non-negative x coordinate matching the
+// little-endian encoded Montgomery u coordinate, following the birational map of RFC 7748, y = (u - 1) / (u + 1). It
+// returns an error if u = -1, which has no corresponding point. The output doesn't decode if u is not on the curve.
+func EncodeFromMontgomery(u []byte) ([]byte, error) {
+	var fu, y, recip field.Element
+	if _, err := fu.SetBytes(u); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	recip.Add(&fu, feOne)
+	if recip.Equal(new(field.Element).Zero()) == 1 {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	y.Multiply(y.Subtract(&fu, feOne), recip.Invert(&recip))
+
+	return y.Bytes(), nil
+}
+
 func decodeElement(element []byte) (*ed.Point, error) {
 	if len(element) == 0 {
 		return nil, internal.ErrParamInvalidPointEncoding
@@ -11,9 +11,11 @@ package edwards25519
 
 import (
 	"crypto"
+	"math/big"
 	"slices"
 
 	ed "filippo.io/edwards25519"
+	"github.com/0xBridge/hash2curve"
 
 	"github.com/0xBridge/ecc/internal"
 )
@@ -49,6 +51,26 @@ func (g Group) Base() internal.Element {
 	return &Element{*ed.NewGeneratorPoint()}
 }
 
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar.
+func (g Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	sc := assert(scalar)
+	return &Element{*ed.NewIdentityPoint().ScalarBaseMult(&sc.scalar)}
+}
+
+// VarTimeMultiScalarMult returns a new element set to the sum of the products of the scalars and the elements, in
+// variable time.
+func (g Group) VarTimeMultiScalarMult(scalars []internal.Scalar, elements []internal.Element) internal.Element {
+	s := make([]*ed.Scalar, len(scalars))
+	e := make([]*ed.Point, len(elements))
+
+	for i, scalar := range scalars {
+		s[i] = &assert(scalar).scalar
+		e[i] = &checkElement(elements[i]).element
+	}
+
+	return &Element{*ed.NewIdentityPoint().VarTimeMultiScalarMult(s, e)}
+}
+
 // HashFunc returns the RFC9380 associated hash function of the group.
 func (g Group) HashFunc() crypto.Hash {
 	return crypto.SHA512
@@ -60,6 +82,40 @@ func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
 	return &Scalar{*HashToEdwards25519Field(input, dst)}
 }
 
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g Group) UniformLengths() (element, scalar uint) {
+	return 2 * secLength, secLength
+}
+
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g Group) ElementFromUniform(uniform []byte) internal.Element {
+	return &Element{*PointFromUniform(uniform)}
+}
+
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g Group) ScalarFromUniform(uniform []byte) internal.Scalar {
+	return &Scalar{*ScalarFromUniform(uniform)}
+}
+
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	u := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, count, 1, 48, &order)
+	out := make([]internal.Scalar, len(u))
+
+	for i, ui := range u {
+		out[i] = new(Scalar).SetBytesReduced(ui.Bytes())
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite.
+func (g Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, count, 1, 48, fieldPrime)
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) internal.Element {
@@ -91,3 +147,16 @@ func (g Group) ElementLength() int {
 func (g Group) Order() []byte {
 	return slices.Clone(orderBytes)
 }
+
+// FieldPrime returns the big-endian encoding of the order of the base field, p = 2^255 - 19.
+func (g Group) FieldPrime() []byte {
+	return fieldPrime.FillBytes(make([]byte, canonicalEncodingLength))
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar, i.e. 8.
+func (g Group) Cofactor() []byte {
+	out := make([]byte, canonicalEncodingLength)
+	out[0] = 8
+
+	return out
+}
@@ -15,6 +15,8 @@ import (
 	"filippo.io/edwards25519"
 	"filippo.io/edwards25519/field"
 	"github.com/0xBridge/hash2curve"
+
+	"github.com/0xBridge/ecc/internal"
 )
 
 const (
@@ -24,6 +26,9 @@ const (
 	// p25519 is the prime 2^255 - 19 for the field.
 	// = 0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed.
 	p25519 = "57896044618658097711785492504343953926634992332820282019728792003956564819949"
+
+	// secLength is the expansion length L of hash_to_field, for a security level of k = 128 bits.
+	secLength = 48
 )
 
 var (
@@ -92,7 +97,12 @@ func reverse(b []byte) []byte {
 
 // HashToEdwards25519Field implements hash-to-scalar mapping modulo the order of Edwards25519 using input with dst.
 func HashToEdwards25519Field(input, dst []byte) *edwards25519.Scalar {
-	sc := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, 1, 1, 48, &order)
+	return ScalarFromUniform(hash2curve.ExpandXMD(crypto.SHA512, input, dst, secLength))
+}
+
+// ScalarFromUniform returns the scalar HashToEdwards25519Field maps the uniform bytes of its expansion to.
+func ScalarFromUniform(uniform []byte) *edwards25519.Scalar {
+	sc := internal.ReduceUniform(uniform, 1, &order)
 	b := adjust(sc[0].Bytes())
 
 	s, err := edwards25519.NewScalar().SetCanonicalBytes(b)
@@ -105,7 +115,12 @@ func HashToEdwards25519Field(input, dst []byte) *edwards25519.Scalar {
 
 // HashToEdwards25519 implements hash-to-curve mapping to Edwards25519 of input with dst.
 func HashToEdwards25519(input, dst []byte) *edwards25519.Point {
-	u := hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, 2, 1, 48, fieldPrime)
+	return PointFromUniform(hash2curve.ExpandXMD(crypto.SHA512, input, dst, 2*secLength))
+}
+
+// PointFromUniform returns the point HashToEdwards25519 maps the uniform bytes of its expansion to.
+func PointFromUniform(uniform []byte) *edwards25519.Point {
+	u := internal.ReduceUniform(uniform, 2, fieldPrime)
 	q0 := element(adjust(u[0].Bytes()))
 	q1 := element(adjust(u[1].Bytes()))
 	p0 := Elligator2Edwards(q0)
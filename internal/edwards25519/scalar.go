@@ -22,10 +22,13 @@ import (
 const inputLength = 64
 
 var (
-	scZero     Scalar
-	scOne      Scalar
-	order      big.Int
-	scMinusOne = []byte{
+	scZero Scalar
+	scOne  Scalar
+	order  big.Int
+
+	// scalarMinusOne is order-1, set at initialization.
+	scalarMinusOne = ed.NewScalar()
+	scMinusOne     = []byte{
 		236, 211, 245, 92, 26, 99, 18, 88, 214, 156, 247, 162, 222, 249, 222, 20,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
 	}
@@ -52,6 +55,10 @@ func init() {
 		panic(err)
 	}
 
+	if _, err := scalarMinusOne.SetCanonicalBytes(scMinusOne); err != nil {
+		panic(err)
+	}
+
 	if _, ok := order.SetString(orderPrime, 10); !ok {
 		panic(internal.ErrBigIntConversion)
 	}
@@ -62,13 +69,15 @@ type Scalar struct {
 	scalar ed.Scalar
 }
 
+// assert returns the input cast to a Scalar, without copying it: callers only read it, and the edwards25519 scalar
+// operations accept operands aliasing the receiver.
 func assert(scalar internal.Scalar) *Scalar {
 	sc, ok := scalar.(*Scalar)
 	if !ok {
 		panic(internal.ErrCastScalar)
 	}
 
-	return &Scalar{*ed.NewScalar().Set(&sc.scalar)}
+	return sc
 }
 
 func (s *Scalar) set(scalar *ed.Scalar) {
@@ -153,6 +162,23 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+func (s *Scalar) MultiplyAdd(a, b internal.Scalar) internal.Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	sa := assert(a)
+	sb := assert(b)
+	s.scalar.MultiplyAdd(&s.scalar, &sa.scalar, &sb.scalar)
+
+	return s
+}
+
 func getMSBit(in byte) int {
 	for i := 7; i >= 0; i-- {
 		mask := byte(1 << uint(i))
@@ -181,12 +207,27 @@ func (s *Scalar) square() {
 }
 
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
 func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.One()
+	}
+
+	sc := assert(scalar)
+
+	if sc.IsZero() {
+		return s.One()
+	}
+
+	if s.IsZero() {
+		return s
+	}
+
 	s1 := s.copy()
 	s2 := s.copy()
 	s2.square()
 
-	bytes := assert(scalar).scalar.Bytes()
+	bytes := sc.scalar.Bytes()
 	msbyte := getMSByte(bytes)
 	msbit := getMSBit(bytes[msbyte])
 
@@ -228,7 +269,7 @@ func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
-// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. The inverse of 0 is 0.
 func (s *Scalar) Invert() internal.Scalar {
 	s.scalar.Invert(&s.scalar)
 	return s
@@ -270,6 +311,17 @@ func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	return 1
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := assert(scalar)
+	return internal.CompareLittleEndian(s.Encode(), sc.Encode())
+}
+
+// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return internal.BitLittleEndian(s.Encode(), i)
+}
+
 // IsZero returns whether the scalar is 0.
 func (s *Scalar) IsZero() bool {
 	return s.scalar.Equal(ed.NewScalar()) == 1
@@ -347,6 +399,17 @@ func (s *Scalar) decodeScalar(scalar []byte) error {
 	return nil
 }
 
+// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetBytesReduced(in []byte) internal.Scalar {
+	reduced := internal.Reverse(internal.ReduceBigEndian(in, &order, canonicalEncodingLength))
+	if err := s.decodeScalar(reduced); err != nil {
+		// This cannot happen, since the value is reduced.
+		panic(fmt.Sprintf("unexpected decoding of reduced scalar: %s", err))
+	}
+
+	return s
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
 	return s.decodeScalar(in)
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package edwards448 allows simple and abstracted operations in the Edwards448 group.
+package edwards448
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/curve448"
+)
+
+// Element implements the Element interface for the Edwards448 group element.
+type Element struct {
+	point *curve448.Point
+}
+
+func newElement() *Element {
+	return &Element{point: curve448.Identity()}
+}
+
+func checkElement(element internal.Element) *Element {
+	if element == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	ec, ok := element.(*Element)
+	if !ok {
+		panic(internal.ErrCastElement)
+	}
+
+	return ec
+}
+
+// encode returns the RFC 8032 encoding of p: the little-endian y coordinate, with the sign of x in the most
+// significant bit of the last byte.
+func encode(p *curve448.Point) []byte {
+	x, y := p.Affine()
+	out := internal.Reverse(y.FillBytes(make([]byte, canonicalEncodingLength)))
+	out[canonicalEncodingLength-1] |= byte(x.Bit(0)) << 7
+
+	return out
+}
+
+// decode returns the point encoded in data following RFC 8032, and false if the encoding is invalid or not canonical.
+func decode(data []byte) (*curve448.Point, bool) {
+	if len(data) != canonicalEncodingLength || data[canonicalEncodingLength-1]&0x7f != 0 {
+		return nil, false
+	}
+
+	sign := uint(data[canonicalEncodingLength-1] >> 7)
+	y := new(big.Int).SetBytes(internal.Reverse(data[:canonicalEncodingLength-1]))
+
+	if y.Cmp(curve448.Fp.Order()) >= 0 {
+		return nil, false
+	}
+
+	// x^2 = (y^2 - 1) / (d * y^2 - 1)
+	y2 := mul(y, y)
+	u := sub(y2, one)
+	v := sub(mul(curve448.D, y2), one)
+
+	x, ok := sqrt(mul(u, curve448.Invert(v)))
+	if !ok {
+		return nil, false
+	}
+
+	if x.Sign() == 0 && sign == 1 {
+		return nil, false
+	}
+
+	if x.Bit(0) != sign {
+		x = neg(x)
+	}
+
+	return curve448.NewAffinePoint(x, y), true
+}
+
+// clearCofactor returns 4 * p.
+func clearCofactor(p *curve448.Point) *curve448.Point {
+	return p.Double().Double()
+}
+
+// Group returns the group's Identifier.
+func (e *Element) Group() byte {
+	return Identifier
+}
+
+// Base sets the element to the group's base point a.k.a. canonical generator.
+func (e *Element) Base() internal.Element {
+	e.point = base.Copy()
+	return e
+}
+
+// Identity sets the element to the identity element of the group.
+func (e *Element) Identity() internal.Element {
+	e.point = curve448.Identity()
+	return e
+}
+
+// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
+func (e *Element) Add(element internal.Element) internal.Element {
+	ec := checkElement(element)
+	e.point = e.point.Add(ec.point)
+
+	return e
+}
+
+// Double sets the receiver to its double, and returns it.
+func (e *Element) Double() internal.Element {
+	e.point = e.point.Double()
+	return e
+}
+
+// Negate sets the receiver to its negation, and returns it.
+func (e *Element) Negate() internal.Element {
+	e.point = e.point.Negate()
+	return e
+}
+
+// Subtract subtracts the input from the receiver, and returns the receiver.
+func (e *Element) Subtract(element internal.Element) internal.Element {
+	ec := checkElement(element)
+	e.point = e.point.Add(ec.point.Negate())
+
+	return e
+}
+
+// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
+func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
+	if scalar == nil {
+		return e.Identity()
+	}
+
+	sc := curve448.NewScalar(Identifier, canonicalEncodingLength).Assert(scalar)
+	e.point = curve448.ScalarMult(sc.Encode(), e.point)
+
+	return e
+}
+
+// Equal returns 1 if the elements are equivalent, and 0 otherwise.
+func (e *Element) Equal(element internal.Element) int {
+	ec := checkElement(element)
+	if e.point.Equal(ec.point) {
+		return 1
+	}
+
+	return 0
+}
+
+// ClearCofactor sets the receiver to its product with the cofactor 4, and returns it.
+func (e *Element) ClearCofactor() internal.Element {
+	e.point = clearCofactor(e.point)
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order subgroup, i.e. whether it is not
+// the identity and [order]e is the identity. Small-order and mixed-order points, which can be decoded, are invalid.
+func (e *Element) IsValid() bool {
+	if e.IsIdentity() {
+		return false
+	}
+
+	order := internal.Reverse(curve448.Order.FillBytes(make([]byte, canonicalEncodingLength)))
+
+	return curve448.ScalarMult(order, e.point).Equal(curve448.Identity())
+}
+
+// IsIdentity returns whether the Element is the identity element of the group.
+func (e *Element) IsIdentity() bool {
+	return e.point.Equal(curve448.Identity())
+}
+
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it. The selection
+// is done on the fixed-size encodings of the coordinates without branching on choice, but the big.Int based arithmetic
+// of this backend is not constant time.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := checkElement(element)
+	dst := []*big.Int{e.point.X, e.point.Y, e.point.Z, e.point.T}
+	src := []*big.Int{ec.point.X, ec.point.Y, ec.point.Z, ec.point.T}
+	p := make([]*big.Int, len(dst))
+
+	for i := range dst {
+		coordinate := dst[i].FillBytes(make([]byte, canonicalEncodingLength))
+		subtle.ConstantTimeCopy(choice, coordinate, src[i].FillBytes(make([]byte, canonicalEncodingLength)))
+		p[i] = new(big.Int).SetBytes(coordinate)
+	}
+
+	e.point = &curve448.Point{X: p[0], Y: p[1], Z: p[2], T: p[3]}
+
+	return e
+}
+
+// Set sets the receiver to the value of the argument, and returns the receiver.
+func (e *Element) Set(element internal.Element) internal.Element {
+	if element == nil {
+		return e.Identity()
+	}
+
+	ec := checkElement(element)
+	e.point = ec.point.Copy()
+
+	return e
+}
+
+// Copy returns a copy of the receiver.
+func (e *Element) Copy() internal.Element {
+	return &Element{point: e.point.Copy()}
+}
+
+// Encode returns the compressed byte encoding of the element.
+func (e *Element) Encode() []byte {
+	return encode(e.point)
+}
+
+// montgomery returns the curve448 coordinates (u, v) of the element, following the birational map of RFC 7748,
+// u = y^2 / x^2 and v = (2 - x^2 - y^2) * y / x^3. They are both 0 for the identity and the point of order 2.
+func (e *Element) montgomery() (u, v *big.Int) {
+	x, y := e.point.Affine()
+	x2, y2 := mul(x, x), mul(y, y)
+	x3Inv := curve448.Invert(mul(x2, x))
+
+	u = mul(mul(y2, x), x3Inv)
+	v = mul(mul(sub(sub(two, x2), y2), y), x3Inv)
+
+	return u, v
+}
+
+// YCoordinate returns the little-endian encoded Montgomery v coordinate of the element, matching the u coordinate
+// returned by XCoordinate.
+func (e *Element) YCoordinate() []byte {
+	_, v := e.montgomery()
+	return internal.Reverse(v.FillBytes(make([]byte, fieldLength)))
+}
+
+// XCoordinate returns the little-endian encoded Montgomery u coordinate of the element, as in RFC 7748. Note that
+// there's no inverse function for this, and that decoding this output might result in another point.
+func (e *Element) XCoordinate() []byte {
+	u, _ := e.montgomery()
+	return internal.Reverse(u.FillBytes(make([]byte, fieldLength)))
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+func (e *Element) Decode(data []byte) error {
+	p, ok := decode(data)
+	if !ok {
+		return fmt.Errorf("invalid edwards448 encoding: %w", internal.ErrParamInvalidPointEncoding)
+	}
+
+	// superfluous identity check
+	if p.Equal(curve448.Identity()) {
+		return fmt.Errorf("invalid edwards448 encoding: %w", internal.ErrIdentity)
+	}
+
+	e.point = p
+
+	return nil
+}
+
+// Hex returns the fixed-sized hexadecimal encoding of e.
+func (e *Element) Hex() string {
+	return hex.EncodeToString(e.Encode())
+}
+
+// DecodeHex sets e to the decoding of the hex encoded element.
+func (e *Element) DecodeHex(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return e.Decode(b)
+}
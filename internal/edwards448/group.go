@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package edwards448 allows simple and abstracted operations in the Edwards448 group. As decaf448, it relies on the
+// variable-time arithmetic of the curve448 package, and must not be used with secret scalars where timing side channels
+// matter.
+package edwards448
+
+import (
+	"crypto"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/0xBridge/hash2curve"
+	"github.com/bytemare/hash"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/curve448"
+)
+
+const (
+	// Identifier distinguishes this group from the others by a byte representation.
+	Identifier = byte(9)
+
+	// canonicalEncodingLength is the byte size of encoded scalars and elements, as specified in RFC 8032.
+	canonicalEncodingLength = 57
+
+	// fieldLength is the byte size of an encoded field element.
+	fieldLength = 56
+)
+
+var (
+	base = mustDecode("14fa30f25b790898adc8d74e2c13bdfdc4397ce61cffd33ad7c2a0051e9c78874098a36c7373ea4b62c7c9563720768824bcb66e71463f6900")
+
+	// baseTable holds precomputed multiples of the base point.
+	baseTable = curve448.NewTable(base, canonicalEncodingLength)
+)
+
+func mustDecode(h string) *curve448.Point {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		panic(err)
+	}
+
+	p, ok := decode(b)
+	if !ok {
+		panic(internal.ErrParamInvalidPointEncoding)
+	}
+
+	return p
+}
+
+// Group represents the Edwards448 group. It exposes a prime-order group API with hash-to-curve operations. This
+// implementation relies on big.Int arithmetic, and is therefore not constant time.
+type Group struct{}
+
+// New returns a new instantiation of the Edwards448 Group.
+func New() internal.Group {
+	return Group{}
+}
+
+// NewScalar returns a new scalar set to 0.
+func (g Group) NewScalar() internal.Scalar {
+	return curve448.NewScalar(Identifier, canonicalEncodingLength)
+}
+
+// NewElement returns the identity element.
+func (g Group) NewElement() internal.Element {
+	return newElement()
+}
+
+// Base returns the group's base point a.k.a. canonical generator.
+func (g Group) Base() internal.Element {
+	return newElement().Base()
+}
+
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar, as the sum of one
+// precomputed multiple of the base point per digit of the scalar, which avoids all doublings.
+func (g Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	sc := curve448.NewScalar(Identifier, canonicalEncodingLength).Assert(scalar)
+	return &Element{point: baseTable.Multiply(sc.Encode())}
+}
+
+// HashFunc returns SHA2-512. The RFC9380 hash-to-curve suite of Edwards448 uses the SHAKE256 extendable output
+// function, which has no crypto.Hash identifier, so SHA2-512 is used where a fixed output hash function is needed.
+func (g Group) HashFunc() crypto.Hash {
+	return crypto.SHA512
+}
+
+// HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
+	return g.ScalarFromUniform(hash2curve.ExpandXOF(hash.SHAKE256.GetXOF(), input, dst, secLength))
+}
+
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g Group) UniformLengths() (element, scalar uint) {
+	return 2 * secLength, secLength
+}
+
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g Group) ElementFromUniform(uniform []byte) internal.Element {
+	return &Element{point: fromUniform(uniform)}
+}
+
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g Group) ScalarFromUniform(uniform []byte) internal.Scalar {
+	s := internal.ReduceUniform(uniform, 1, &curve448.Order)[0]
+	return curve448.NewScalar(Identifier, canonicalEncodingLength).SetBytesReduced(s.Bytes())
+}
+
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	u := hashToField(input, dst, count, &curve448.Order)
+	out := make([]internal.Scalar, len(u))
+
+	for i, ui := range u {
+		out[i] = curve448.NewScalar(Identifier, canonicalEncodingLength).SetBytesReduced(ui.Bytes())
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite.
+func (g Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hashToField(input, dst, count, curve448.Fp.Order())
+}
+
+// HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToGroup(input, dst []byte) internal.Element {
+	return &Element{point: hashToEdwards448(input, dst)}
+}
+
+// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
+	return &Element{point: encodeToEdwards448(input, dst)}
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier.
+func (g Group) Ciphersuite() string {
+	return H2C
+}
+
+// ScalarLength returns the byte size of an encoded scalar.
+func (g Group) ScalarLength() int {
+	return canonicalEncodingLength
+}
+
+// ElementLength returns the byte size of an encoded element.
+func (g Group) ElementLength() int {
+	return canonicalEncodingLength
+}
+
+// Order returns the order of the canonical group of scalars.
+func (g Group) Order() []byte {
+	return curve448.OrderBytes(canonicalEncodingLength)
+}
+
+// FieldPrime returns the big-endian encoding of the order of the base field, p = 2^448 - 2^224 - 1.
+func (g Group) FieldPrime() []byte {
+	return curve448.Fp.Order().FillBytes(make([]byte, fieldLength))
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar, i.e. 4.
+func (g Group) Cofactor() []byte {
+	out := make([]byte, canonicalEncodingLength)
+	out[0] = 4
+
+	return out
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package edwards448
+
+import (
+	"math/big"
+
+	"github.com/0xBridge/hash2curve"
+	"github.com/bytemare/hash"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/curve448"
+)
+
+const (
+	// H2C represents the hash-to-curve string identifier.
+	H2C = "edwards448_XOF:SHAKE256_ELL2_RO_"
+
+	// E2C represents the encode-to-curve string identifier.
+	E2C = "edwards448_XOF:SHAKE256_ELL2_NU_"
+
+	// secLength is the expansion length L of hash_to_field, for a security level of k = 224 bits.
+	secLength = 84
+)
+
+// Shorthands for the field arithmetic.
+var (
+	mul = curve448.Mul
+	add = curve448.Add
+	sub = curve448.Sub
+	neg = curve448.Neg
+)
+
+var (
+	zero  = big.NewInt(0)
+	one   = big.NewInt(1)
+	two   = big.NewInt(2)
+	four  = big.NewInt(4)
+	montA = big.NewInt(156326)
+
+	// sqrtExponent = (p + 1) / 4, as p = 3 mod 4.
+	sqrtExponent = new(big.Int).Rsh(new(big.Int).Add(curve448.Fp.Order(), one), 2)
+)
+
+// sqrt returns a square root of x and true if x is a square, and false otherwise.
+func sqrt(x *big.Int) (*big.Int, bool) {
+	r := curve448.Fp.Exponent(new(big.Int), x, sqrtExponent)
+	return r, mul(r, r).Cmp(x) == 0
+}
+
+// sgn0 returns the parity of x, as specified in RFC 9380.
+func sgn0(x *big.Int) uint {
+	return x.Bit(0)
+}
+
+// montgomeryRHS returns x^3 + A * x^2 + x, the right-hand side of the curve448 equation.
+func montgomeryRHS(x *big.Int) *big.Int {
+	x2 := mul(x, x)
+	return add(add(mul(x2, x), mul(montA, x2)), x)
+}
+
+// elligator2 maps the field element u to a point (x, y) of curve448, using the Elligator 2 method with Z = -1, as
+// specified in RFC 9380.
+func elligator2(u *big.Int) (x, y *big.Int) {
+	// x1 = -A / (1 + Z * u^2), and x1 = -A on exceptional cases.
+	x1 := mul(neg(montA), curve448.Invert(sub(one, mul(u, u))))
+	if x1.Sign() == 0 {
+		x1 = neg(montA)
+	}
+
+	if y1, ok := sqrt(montgomeryRHS(x1)); ok {
+		if sgn0(y1) != 1 {
+			y1 = neg(y1)
+		}
+
+		return x1, y1
+	}
+
+	x2 := sub(neg(x1), montA)
+	y2, _ := sqrt(montgomeryRHS(x2))
+
+	if sgn0(y2) != 0 {
+		y2 = neg(y2)
+	}
+
+	return x2, y2
+}
+
+// isogeny maps the curve448 point (u, v) to edwards448 with the 4-isogeny specified in RFC 7748. Points for which a
+// denominator is zero are mapped to the identity.
+func isogeny(u, v *big.Int) *curve448.Point {
+	u2 := mul(u, u)
+	u3 := mul(u2, u)
+	u4 := mul(u3, u)
+	u5 := mul(u4, u)
+	v2 := mul(v, v)
+
+	// x = 4 * v * (u^2 - 1) / (u^4 - 2 * u^2 + 4 * v^2 + 1)
+	xn := mul(mul(four, v), sub(u2, one))
+	xd := add(add(sub(u4, mul(two, u2)), mul(four, v2)), one)
+
+	// y = -(u^5 - 2 * u^3 - 4 * u * v^2 + u) / (u^5 - 2 * u^2 * v^2 - 2 * u^3 - 2 * v^2 + u)
+	yn := neg(add(sub(sub(u5, mul(two, u3)), mul(mul(four, u), v2)), u))
+	yd := add(sub(sub(sub(u5, mul(mul(two, u2), v2)), mul(two, u3)), mul(two, v2)), u)
+
+	if xd.Cmp(zero) == 0 || yd.Cmp(zero) == 0 {
+		return curve448.Identity()
+	}
+
+	return curve448.NewAffinePoint(mul(xn, curve448.Invert(xd)), mul(yn, curve448.Invert(yd)))
+}
+
+// mapToCurve maps the field element u to a point of edwards448, without clearing the cofactor.
+func mapToCurve(u *big.Int) *curve448.Point {
+	return isogeny(elligator2(u))
+}
+
+func hashToField(input, dst []byte, count uint, modulo *big.Int) []*big.Int {
+	return hash2curve.HashToFieldXOF(hash.SHAKE256.GetXOF(), input, dst, count, 1, secLength, modulo)
+}
+
+// hashToEdwards448 implements the hash_to_curve function of the edwards448_XOF:SHAKE256_ELL2_RO_ suite.
+func hashToEdwards448(input, dst []byte) *curve448.Point {
+	return fromUniform(hash2curve.ExpandXOF(hash.SHAKE256.GetXOF(), input, dst, 2*secLength))
+}
+
+// fromUniform returns the point hashToEdwards448 maps the uniform bytes of its expansion to.
+func fromUniform(uniform []byte) *curve448.Point {
+	u := internal.ReduceUniform(uniform, 2, curve448.Fp.Order())
+	p := mapToCurve(u[0]).Add(mapToCurve(u[1]))
+
+	return clearCofactor(p)
+}
+
+// encodeToEdwards448 implements the encode_to_curve function of the edwards448_XOF:SHAKE256_ELL2_NU_ suite.
+func encodeToEdwards448(input, dst []byte) *curve448.Point {
+	u := hashToField(input, dst, 1, curve448.Fp.Order())
+	return clearCofactor(mapToCurve(u[0]))
+}
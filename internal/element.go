@@ -41,6 +41,16 @@ type Element interface {
 	// IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 	IsIdentity() bool
 
+	// ClearCofactor sets the receiver to its product with the group's cofactor, and returns it.
+	ClearCofactor() Element
+
+	// IsValid returns whether the element is a non-identity element of the prime-order subgroup.
+	IsValid() bool
+
+	// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it. It runs in
+	// constant time whenever the underlying implementation allows it.
+	CMov(element Element, choice int) Element
+
 	// Set sets the receiver to the value of the argument, and returns the receiver.
 	Set(Element) Element
 
@@ -53,7 +63,11 @@ type Element interface {
 	// XCoordinate returns the encoded x coordinate of the element.
 	XCoordinate() []byte
 
-	// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+	// YCoordinate returns the encoded y coordinate of the element.
+	YCoordinate() []byte
+
+	// Decode sets the receiver to a decoding of the input data, and returns an error on failure, leaving the receiver
+	// unchanged: implementations must fully validate the input before modifying the receiver.
 	Decode(data []byte) error
 
 	// Hex returns the fixed-sized hexadecimal encoding of e.
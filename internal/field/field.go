@@ -13,8 +13,12 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"sync"
 )
 
+// scratch holds temporary big.Int values, whose backing arrays are reused across operations to spare allocations.
+var scratch = sync.Pool{New: func() any { return new(big.Int) }}
+
 // String2Int returns a big.Int representation of the integer s.
 func String2Int(s string) big.Int {
 	if p, _ := new(big.Int).SetString(s, 0); p != nil {
@@ -30,6 +34,7 @@ type Field struct {
 	pMinus1div2 *big.Int // used in IsSquare
 	pMinus2     *big.Int // used for Field big.Int inversion
 	exp         *big.Int
+	mont        *montgomery // used for constant-time inversion
 	byteLen     int
 }
 
@@ -54,6 +59,7 @@ func NewField(prime *big.Int) Field {
 		pMinus1div2: pMinus1div2,
 		pMinus2:     pMinus2,
 		exp:         exp,
+		mont:        newMontgomery(prime),
 		byteLen:     (prime.BitLen() + 7) / 8,
 	}
 }
@@ -96,6 +102,44 @@ func (f Field) Inv(res, x *big.Int) {
 	f.Exponent(res, x, f.pMinus2)
 }
 
+// InvConstantTime sets res to the modular inverse of x mod field order, like Inv, but in constant time with respect to
+// x, with a fixed addition chain for the exponent order - 2 over fixed-size limbs. x must be reduced. The inverse of 0
+// is 0.
+func (f Field) InvConstantTime(res, x *big.Int) {
+	f.mont.invert(res, x)
+}
+
+// BatchInv sets each of the elements to its modular inverse, like Inv, but with a single inversion for all of them
+// (Montgomery's trick). Zero elements are left as is, i.e. the inverse of 0 is 0.
+func (f Field) BatchInv(elements []*big.Int) {
+	// prefix[i] is the product of the non-zero elements before i.
+	prefix := make([]*big.Int, len(elements))
+	acc := big.NewInt(1)
+
+	for i, e := range elements {
+		prefix[i] = new(big.Int).Set(acc)
+
+		if !f.IsZero(e) {
+			f.Mul(acc, acc, e)
+		}
+	}
+
+	f.Inv(acc, acc)
+
+	var inv big.Int
+
+	for i := len(elements) - 1; i >= 0; i-- {
+		e := elements[i]
+		if f.IsZero(e) {
+			continue
+		}
+
+		f.Mul(&inv, acc, prefix[i])
+		f.Mul(acc, acc, e)
+		e.Set(&inv)
+	}
+}
+
 // Exponent returns x^n mod field order.
 func (f Field) Exponent(res, x, n *big.Int) *big.Int {
 	return res.Exp(x, n, f.order)
@@ -108,7 +152,16 @@ func (f Field) IsEqual(f2 *Field) bool {
 
 // Mod reduces x modulo the field order.
 func (f Field) Mod(x *big.Int) *big.Int {
-	return x.Mod(x, f.order)
+	// QuoRem doesn't allocate the quotient, contrary to Mod, but its remainder has the sign of x.
+	q := scratch.Get().(*big.Int)
+	q.QuoRem(x, f.order, x)
+	scratch.Put(q)
+
+	if x.Sign() < 0 {
+		x.Add(x, f.order)
+	}
+
+	return x
 }
 
 // Add sets res to x + y modulo the field order.
@@ -123,5 +176,8 @@ func (f Field) Sub(res, x, y *big.Int) *big.Int {
 
 // Mul sets res to the multiplication of x and y modulo the field order.
 func (f Field) Mul(res, x, y *big.Int) {
-	f.Mod(res.Mul(x, y))
+	// The product is computed in a scratch value, since big.Int allocates when the result aliases an operand.
+	product := scratch.Get().(*big.Int)
+	res.Set(f.Mod(product.Mul(x, y)))
+	scratch.Put(product)
 }
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package field
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// chainWindow is the width, in bits, of the exponent digits of the addition chain used for inversion.
+const chainWindow = 4
+
+// montgomery holds the parameters of the Montgomery representation modulo an odd prime, with fixed-size limbs so that
+// its arithmetic runs in constant time, contrary to big.Int's.
+type montgomery struct {
+	// n is the modulus, and rr = R^2 mod n for R = 2^(64 * len(n)), both as little-endian 64-bit limbs.
+	n  []uint64
+	rr []uint64
+
+	// k0 = -n^-1 mod 2^64.
+	k0 uint64
+
+	// chain holds the base 2^chainWindow digits of n - 2, most significant first, which is the addition chain of the
+	// exponentiation computing inverses: each digit costs chainWindow squarings and a multiplication by the power of
+	// the input it indexes, whatever the input.
+	chain []int
+}
+
+func newMontgomery(prime *big.Int) *montgomery {
+	size := (prime.BitLen() + 63) / 64
+	m := &montgomery{
+		n:  toLimbs(prime, size),
+		rr: make([]uint64, size),
+	}
+
+	rr := new(big.Int).Lsh(big.NewInt(1), uint(128*size))
+	m.rr = toLimbs(rr.Mod(rr, prime), size)
+
+	// Newton's iteration doubles the number of correct low bits of the inverse at each step.
+	inv := uint64(1)
+	for range 6 {
+		inv *= 2 - m.n[0]*inv
+	}
+
+	m.k0 = -inv
+
+	exp := new(big.Int).Sub(prime, big.NewInt(2))
+	for i := (exp.BitLen()+chainWindow-1)/chainWindow - 1; i >= 0; i-- {
+		digit := 0
+		for j := range chainWindow {
+			digit |= int(exp.Bit(i*chainWindow+j)) << j
+		}
+
+		m.chain = append(m.chain, digit)
+	}
+
+	return m
+}
+
+// toLimbs returns the little-endian 64-bit limbs of x, which must fit in size limbs.
+func toLimbs(x *big.Int, size int) []uint64 {
+	buf := x.FillBytes(make([]byte, 8*size))
+	limbs := make([]uint64, size)
+
+	for i := range limbs {
+		for j := range 8 {
+			limbs[i] |= uint64(buf[len(buf)-1-8*i-j]) << (8 * j)
+		}
+	}
+
+	return limbs
+}
+
+// fromLimbs sets x to the integer of the little-endian 64-bit limbs.
+func fromLimbs(x *big.Int, limbs []uint64) {
+	buf := make([]byte, 8*len(limbs))
+	for i, l := range limbs {
+		for j := range 8 {
+			buf[len(buf)-1-8*i-j] = byte(l >> (8 * j))
+		}
+	}
+
+	x.SetBytes(buf)
+}
+
+// mul sets z = x * y / R mod n, for x, y < n, with the coarsely integrated operand scanning method, using t as scratch
+// space of len(n) + 2 limbs. z may alias x or y.
+func (m *montgomery) mul(z, x, y, t []uint64) {
+	size := len(m.n)
+	clear(t)
+
+	for i := range size {
+		// t += x * y[i]
+		var c, carry uint64
+		for j := range size {
+			hi, lo := bits.Mul64(x[j], y[i])
+			lo, carry = bits.Add64(lo, t[j], 0)
+			hi += carry
+			lo, carry = bits.Add64(lo, c, 0)
+			t[j], c = lo, hi+carry
+		}
+
+		t[size], carry = bits.Add64(t[size], c, 0)
+		t[size+1] = carry
+
+		// t = (t + u * n) / 2^64, where u is chosen so that the division is exact.
+		u := t[0] * m.k0
+		hi, lo := bits.Mul64(u, m.n[0])
+		_, carry = bits.Add64(lo, t[0], 0)
+		c = hi + carry
+
+		for j := 1; j < size; j++ {
+			hi, lo = bits.Mul64(u, m.n[j])
+			lo, carry = bits.Add64(lo, t[j], 0)
+			hi += carry
+			lo, carry = bits.Add64(lo, c, 0)
+			t[j-1], c = lo, hi+carry
+		}
+
+		t[size-1], carry = bits.Add64(t[size], c, 0)
+		t[size] = t[size+1] + carry
+	}
+
+	// t < 2n, so a conditional subtraction of n reduces it, selecting t if the subtraction borrows.
+	var borrow uint64
+	for j := range size {
+		z[j], borrow = bits.Sub64(t[j], m.n[j], borrow)
+	}
+
+	_, borrow = bits.Sub64(t[size], 0, borrow)
+	mask := -borrow
+
+	for j := range size {
+		z[j] = t[j]&mask | z[j]&^mask
+	}
+}
+
+// invert sets res to x^(n - 2) mod n, the inverse of x for x != 0 and 0 for x = 0, in constant time with respect to
+// x. x must be reduced.
+func (m *montgomery) invert(res, x *big.Int) {
+	size := len(m.n)
+	t := make([]uint64, size+2)
+	one := make([]uint64, size)
+	one[0] = 1
+
+	// table[d] = x^d * R mod n, for 0 <= d < 2^chainWindow.
+	table := make([][]uint64, 1<<chainWindow)
+	table[1] = toLimbs(x, size)
+	m.mul(table[1], table[1], m.rr, t)
+	table[0] = make([]uint64, size)
+	m.mul(table[0], one, m.rr, t)
+
+	for d := 2; d < len(table); d++ {
+		table[d] = make([]uint64, size)
+		m.mul(table[d], table[d-1], table[1], t)
+	}
+
+	acc := make([]uint64, size)
+	copy(acc, table[m.chain[0]])
+
+	for _, digit := range m.chain[1:] {
+		for range chainWindow {
+			m.mul(acc, acc, acc, t)
+		}
+
+		if digit != 0 {
+			m.mul(acc, acc, table[digit], t)
+		}
+	}
+
+	m.mul(acc, acc, one, t)
+	fromLimbs(res, acc)
+}
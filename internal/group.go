@@ -9,7 +9,10 @@
 // Package internal defines simple and abstract APIs to group Elements and Scalars.
 package internal
 
-import "crypto"
+import (
+	"crypto"
+	"math/big"
+)
 
 // Group abstracts operations in a prime-order group.
 type Group interface {
@@ -22,6 +25,9 @@ type Group interface {
 	// Base returns the group's base point a.k.a. canonical generator.
 	Base() Element
 
+	// ScalarBaseMult returns a new element set to the product of the base point and the scalar.
+	ScalarBaseMult(scalar Scalar) Element
+
 	// HashFunc returns the RFC9380 associated hash function of the group.
 	HashFunc() crypto.Hash
 
@@ -29,6 +35,14 @@ type Group interface {
 	// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 	HashToScalar(input, dst []byte) Scalar
 
+	// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once
+	// as the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+	HashToScalars(input, dst []byte, count uint) []Scalar
+
+	// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+	// expansion parameters of the group's hash-to-curve suite.
+	HashToField(input, dst []byte, count uint) []*big.Int
+
 	// HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 	// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 	HashToGroup(input, dst []byte) Element
@@ -48,4 +62,46 @@ type Group interface {
 
 	// Order returns the order of the canonical group of scalars.
 	Order() []byte
+
+	// Cofactor returns the cofactor of the group, encoded as a scalar.
+	Cofactor() []byte
+
+	// FieldPrime returns the big-endian encoding of the order of the base field of the underlying curve.
+	FieldPrime() []byte
+}
+
+// BatchGroupHasher is optionally implemented by groups that hash several inputs to the group faster than one at a time.
+type BatchGroupHasher interface {
+	// BatchHashToGroup returns HashToGroup(input, dst) for each of the inputs.
+	BatchHashToGroup(inputs [][]byte, dst []byte) []Element
+}
+
+// BatchNormalizer is optionally implemented by groups whose elements are kept in projective coordinates, to set a batch
+// of elements to their affine representation with a single field inversion shared by all of them, so that encoding
+// them afterwards takes no inversion.
+type BatchNormalizer interface {
+	// BatchNormalize sets the elements to their affine representation. The elements must belong to the group.
+	BatchNormalize(elements []Element)
+}
+
+// UniformHasher is optionally implemented by groups whose HashToGroup and HashToScalar only use their input to expand
+// it into uniform bytes with the expand_message function of the group's suite, and map these bytes, so that callers can
+// stream the input into the expansion.
+type UniformHasher interface {
+	// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+	UniformLengths() (element, scalar uint)
+
+	// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+	ElementFromUniform(uniform []byte) Element
+
+	// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+	ScalarFromUniform(uniform []byte) Scalar
+}
+
+// VarTimeMultiScalarMultiplier is optionally implemented by groups whose underlying library provides a variable-time
+// multi-scalar multiplication.
+type VarTimeMultiScalarMultiplier interface {
+	// VarTimeMultiScalarMult returns a new element set to the sum of the products of the scalars and the elements, in
+	// variable time. Both slices have the same length, and hold no nil values.
+	VarTimeMultiScalarMult(scalars []Scalar, elements []Element) Element
 }
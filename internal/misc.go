@@ -13,6 +13,7 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"math/big"
 )
 
 var (
@@ -31,11 +32,14 @@ var (
 	// ErrParamInvalidPointEncoding indicates an invalid point encoding has been provided.
 	ErrParamInvalidPointEncoding = errors.New("invalid point encoding")
 
+	// ErrWrongGroup indicates that values of different groups have been mixed.
+	ErrWrongGroup = errors.New("wrong group")
+
 	// ErrCastElement indicates a failed attempt to cast to a point.
-	ErrCastElement = errors.New("could not cast to same group element (wrong group ?)")
+	ErrCastElement = fmt.Errorf("could not cast to same group element (%w ?)", ErrWrongGroup)
 
 	// ErrCastScalar indicates a failed attempt to cast to a scalar.
-	ErrCastScalar = errors.New("could not cast to same group scalar (wrong group ?)")
+	ErrCastScalar = fmt.Errorf("could not cast to same group scalar (%w ?)", ErrWrongGroup)
 
 	// ErrWrongField indicates an incompatible field has been encountered.
 	ErrWrongField = errors.New("incompatible fields")
@@ -63,6 +67,15 @@ var (
 
 	// ErrDecodingInvalidJSONEncoding indicates an invalid JSON encoding.
 	ErrDecodingInvalidJSONEncoding = errors.New("invalid JSON encoding")
+
+	// ErrPointNotOnCurve indicates that the coordinates of a point do not satisfy the curve equation.
+	ErrPointNotOnCurve = errors.New("point is not on the curve")
+
+	// ErrPointNotInSubgroup indicates that a point is on the curve, but not in its prime-order subgroup.
+	ErrPointNotInSubgroup = errors.New("point is not in the prime-order subgroup")
+
+	// ErrParamLengthMismatch indicates that the scalar and element inputs have different lengths.
+	ErrParamLengthMismatch = errors.New("scalar and element inputs have different lengths")
 )
 
 // An Encoder can encode itself to machine or human-readable forms.
@@ -89,6 +102,82 @@ type Decoder interface {
 	encoding.BinaryUnmarshaler
 }
 
+// CompareBigEndian returns -1 if a < b, 0 if a == b, and +1 if a > b, for the big-endian integers a and b of same
+// length. It runs in constant time with regard to the values of a and b, and panics if the lengths differ.
+func CompareBigEndian(a, b []byte) int {
+	if len(a) != len(b) {
+		panic(ErrParamScalarLength)
+	}
+
+	var gt, lt int
+
+	for i := range a {
+		x, y := int(a[i]), int(b[i])
+		done := gt | lt
+		gt |= ((y - x) >> 31) & 1 &^ done
+		lt |= ((x - y) >> 31) & 1 &^ done
+	}
+
+	return gt - lt
+}
+
+// CompareLittleEndian returns -1 if a < b, 0 if a == b, and +1 if a > b, for the little-endian integers a and b of
+// same length. It runs in constant time with regard to the values of a and b, and panics if the lengths differ.
+func CompareLittleEndian(a, b []byte) int {
+	return CompareBigEndian(Reverse(a), Reverse(b))
+}
+
+// BitBigEndian returns the i-th least significant bit of the big-endian integer in, or 0 if i is out of range.
+func BitBigEndian(in []byte, i int) int {
+	if i < 0 || i >= 8*len(in) {
+		return 0
+	}
+
+	return int(in[len(in)-1-i/8]>>(i%8)) & 1
+}
+
+// BitLittleEndian returns the i-th least significant bit of the little-endian integer in, or 0 if i is out of range.
+func BitLittleEndian(in []byte, i int) int {
+	if i < 0 || i >= 8*len(in) {
+		return 0
+	}
+
+	return int(in[i/8]>>(i%8)) & 1
+}
+
+// ReduceBigEndian interprets in as a big-endian unsigned integer, and returns its value modulo order as a big-endian
+// byte slice of the given length.
+func ReduceBigEndian(in []byte, order *big.Int, length int) []byte {
+	i := new(big.Int).SetBytes(in)
+	i.Mod(i, order)
+
+	return i.FillBytes(make([]byte, length))
+}
+
+// ReduceUniform splits uniform into count chunks of equal length, and returns their big-endian values modulo order, as
+// the RFC9380 hash_to_field function does with the output of expand_message.
+func ReduceUniform(uniform []byte, count int, order *big.Int) []*big.Int {
+	length := len(uniform) / count
+	out := make([]*big.Int, count)
+
+	for i := range out {
+		out[i] = new(big.Int).SetBytes(uniform[i*length : (i+1)*length])
+		out[i].Mod(out[i], order)
+	}
+
+	return out
+}
+
+// Reverse returns a copy of in with its bytes in reverse order.
+func Reverse(in []byte) []byte {
+	out := make([]byte, len(in))
+	for i, b := range in {
+		out[len(in)-1-i] = b
+	}
+
+	return out
+}
+
 // RandomBytes returns random bytes of length len (wrapper for crypto/rand).
 func RandomBytes(length int) []byte {
 	random := make([]byte, length)
@@ -13,8 +13,8 @@ import (
 	"math/big"
 
 	"github.com/0xBridge/hash2curve"
-	hash2curveSecp "github.com/0xBridge/hash2curve/secp256k1"
 
+	"github.com/0xBridge/ecc/internal"
 	"github.com/0xBridge/ecc/internal/field"
 )
 
@@ -22,6 +22,11 @@ type mapping struct {
 	z         big.Int
 	hash      crypto.Hash
 	secLength uint
+
+	// minusBOverA and bOverZA are the constants -B / A and B / (Z * A) of the simplified SWU map, computed once so that
+	// the map itself takes no inversion other than that of its denominator.
+	minusBOverA big.Int
+	bOverZA     big.Int
 }
 
 type curve[point nistECPoint[point]] struct {
@@ -35,6 +40,18 @@ func (c *curve[point]) setMapping(hash crypto.Hash, z string, secLength uint) {
 	c.mapping.hash = hash
 	c.mapping.secLength = secLength
 	c.mapping.z = field.String2Int(z)
+	c.field.Mod(&c.mapping.z)
+
+	f := &c.field
+	a := f.Mod(new(big.Int).Set(&nistWa))
+
+	f.Inv(&c.mapping.minusBOverA, a)
+	f.Mul(&c.mapping.minusBOverA, &c.mapping.minusBOverA, &c.b)
+	f.Sub(&c.mapping.minusBOverA, new(big.Int), &c.mapping.minusBOverA)
+
+	f.Mul(&c.mapping.bOverZA, &c.mapping.z, a)
+	f.Inv(&c.mapping.bOverZA, &c.mapping.bOverZA)
+	f.Mul(&c.mapping.bOverZA, &c.mapping.bOverZA, &c.b)
 }
 
 func (c *curve[point]) setCurveParams(prime *big.Int, b string, newPoint func() point) {
@@ -51,39 +68,128 @@ func (c *curve[point]) encodeXMD(input, dst []byte) point {
 }
 
 func (c *curve[point]) hashXMD(input, dst []byte) point {
-	u := hash2curve.HashToFieldXMD(c.hash, input, dst, 2, 1, c.secLength, c.field.Order())
+	return c.fromUniform(hash2curve.ExpandXMD(c.hash, input, dst, 2*c.secLength))
+}
+
+// fromUniform returns the hash_to_curve mapping of the uniform bytes of the expansion of its input.
+func (c *curve[point]) fromUniform(uniform []byte) point {
+	u := internal.ReduceUniform(uniform, 2, c.field.Order())
 	q0 := c.map2curve(u[0])
 	q1 := c.map2curve(u[1])
 	// We can save cofactor clearing because it is 1.
 	return q0.Add(q0, q1)
 }
 
+// hashXMDBatch returns hashXMD(input, dst) for each of the inputs, sharing a single field inversion across the SSWU
+// maps of all their field elements.
+func (c *curve[point]) hashXMDBatch(inputs [][]byte, dst []byte) []point {
+	u := make([]*big.Int, 0, 2*len(inputs))
+	for _, input := range inputs {
+		u = append(u, hash2curve.HashToFieldXMD(c.hash, input, dst, 2, 1, c.secLength, c.field.Order())...)
+	}
+
+	inverses := make([]*big.Int, len(u))
+	for i, ui := range u {
+		inverses[i] = c.sswuDenominator(ui)
+	}
+
+	c.field.BatchInv(inverses)
+
+	out := make([]point, len(inputs))
+	for i := range out {
+		q0 := c.affineToPoint(c.sswuInverted(u[2*i], inverses[2*i]))
+		q1 := c.affineToPoint(c.sswuInverted(u[2*i+1], inverses[2*i+1]))
+		out[i] = q0.Add(q0, q1)
+	}
+
+	return out
+}
+
 func (c *curve[point]) map2curve(fe *big.Int) point {
-	x, y := hash2curveSecp.MapToCurveSSWU(&nistWa, &c.b, &c.z, fe, c.field.Order())
+	x, y := c.sswu(fe)
 	return c.affineToPoint(x, y)
 }
 
-var (
-	decompressed256 = [65]byte{0x04}
-	decompressed384 = [97]byte{0x04}
-	decompressed521 = [133]byte{0x04}
-)
+// sswu implements the simplified Shallue-van de Woestijne-Ulas method of RFC9380 (section 6.6.2), for a = -3. It
+// doesn't rely on the field order being 3 mod 4, as is the case for P-224.
+func (c *curve[point]) sswu(u *big.Int) (x, y *big.Int) {
+	tv1 := c.sswuDenominator(u)
+	if !c.field.IsZero(tv1) {
+		c.field.Inv(tv1, tv1)
+	}
 
-func (c *curve[point]) affineToPoint(pxc, pyc *big.Int) point {
-	var decompressed []byte
+	return c.sswuInverted(u, tv1)
+}
 
-	byteLen := c.field.ByteLen()
-	switch byteLen {
-	case 32:
-		decompressed = decompressed256[:]
-	case 48:
-		decompressed = decompressed384[:]
-	case 66:
-		decompressed = decompressed521[:]
-	default:
-		panic("invalid byte length")
+// sswuDenominator returns Z^2 * u^4 + Z * u^2, whose inverse sswuInverted needs.
+func (c *curve[point]) sswuDenominator(u *big.Int) *big.Int {
+	f := &c.field
+
+	var u2, tv2 big.Int
+
+	f.Mul(&u2, u, u)
+	f.Mul(&tv2, &c.z, &u2)
+
+	tv1 := new(big.Int)
+	f.Mul(tv1, &tv2, &tv2)
+	f.Add(tv1, tv1, &tv2)
+
+	return tv1
+}
+
+// sswuInverted is sswu given tv1 = 1 / (Z^2 * u^4 + Z * u^2), with inv0(0) = 0, so that callers can share inversions.
+// It takes no inversion itself, using the precomputed constants -B / A and B / (Z * A).
+func (c *curve[point]) sswuInverted(u, tv1 *big.Int) (x, y *big.Int) {
+	f := &c.field
+
+	var tv2, u2, t big.Int
+
+	f.Mul(&u2, u, u)
+	f.Mul(&tv2, &c.z, &u2)
+
+	x = new(big.Int)
+	if f.IsZero(tv1) {
+		// x1 = B / (Z * A)
+		x.Set(&c.bOverZA)
+	} else {
+		// x1 = (-B / A) * (1 + tv1)
+		t.Add(tv1, big.NewInt(1))
+		f.Mul(x, &c.minusBOverA, &t)
 	}
 
+	gx := c.polynomial(x)
+	if big.Jacobi(gx, f.Order()) == -1 {
+		// x2 = Z * u^2 * x1
+		f.Mul(x, x, &tv2)
+		gx = c.polynomial(x)
+	}
+
+	y = new(big.Int).ModSqrt(gx, f.Order())
+	if u.Bit(0) != y.Bit(0) {
+		f.Sub(y, new(big.Int), y)
+	}
+
+	return x, y
+}
+
+// polynomial returns x^3 + A * x + B.
+func (c *curve[point]) polynomial(x *big.Int) *big.Int {
+	var x3, ax big.Int
+
+	c.field.Mul(&x3, x, x)
+	c.field.Mul(&x3, &x3, x)
+	c.field.Mul(&ax, &nistWa, x)
+
+	res := new(big.Int)
+	c.field.Add(res, &x3, &ax)
+	c.field.Add(res, res, &c.b)
+
+	return res
+}
+
+func (c *curve[point]) affineToPoint(pxc, pyc *big.Int) point {
+	byteLen := c.field.ByteLen()
+	decompressed := make([]byte, 1+2*byteLen)
 	decompressed[0] = 0x04
 	pxc.FillBytes(decompressed[1 : 1+byteLen])
 	pyc.FillBytes(decompressed[1+byteLen:])
@@ -12,6 +12,7 @@ import (
 	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"reflect"
 
 	"filippo.io/nistec"
@@ -20,6 +21,7 @@ import (
 )
 
 const (
+	p224CompressedEncodingLength = 29
 	p256CompressedEncodingLength = 33
 	p384CompressedEncodingLength = 49
 	p521CompressedEncodingLength = 67
@@ -47,6 +49,8 @@ func checkElement[Point nistECPoint[Point]](element internal.Element) *Element[P
 // Group returns the group's Identifier.
 func (e *Element[Point]) Group() byte {
 	switch any(e.p).(type) {
+	case *nistec.P224Point:
+		return IdentifierP224
 	case *nistec.P256Point:
 		return IdentifierP256
 	case *nistec.P384Point:
@@ -84,22 +88,34 @@ func (e *Element[Point]) Double() internal.Element {
 	return e
 }
 
-// negateSmall returns the compressed byte encoding of the negated element e with 5 allocations in 13000 ns/op.
+// fieldOrder returns the order of the base field of the element's curve.
+func (e *Element[Point]) fieldOrder() *big.Int {
+	switch any(e.p).(type) {
+	case *nistec.P224Point:
+		return p224.curve.field.Order()
+	case *nistec.P256Point:
+		return p256.curve.field.Order()
+	case *nistec.P384Point:
+		return p384.curve.field.Order()
+	case *nistec.P521Point:
+		return p521.curve.field.Order()
+	}
+
+	panic(fmt.Sprintf("invalid point type %v", reflect.TypeFor[Point]()))
+}
+
+// negateSmall returns the uncompressed byte encoding of the negated element e, i.e. with the y coordinate replaced by
+// p - y, which decodes without the square root a compressed encoding takes.
 func (e *Element[Point]) negateSmall() []byte {
-	enc := e.p.BytesCompressed()
+	enc := e.p.Bytes()
 
 	if e.IsIdentity() {
 		return enc
 	}
 
-	switch enc[0] {
-	case 2:
-		enc[0] = 0x03
-	case 3:
-		enc[0] = 0x02
-	default:
-		panic("invalid encoding header")
-	}
+	size := (len(enc) - 1) / 2
+	y := new(big.Int).SetBytes(enc[1+size:])
+	y.Sub(e.fieldOrder(), y).FillBytes(enc[1+size:])
 
 	return enc
 }
@@ -155,6 +171,18 @@ func (e *Element[Point]) Equal(element internal.Element) int {
 	return subtle.ConstantTimeCompare(e.p.Bytes(), ec.p.Bytes())
 }
 
+// ClearCofactor sets the receiver to its product with the group's cofactor, and returns it. The cofactor is 1, so
+// this is a no-op.
+func (e *Element[P]) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order group. Since the group has
+// cofactor 1, any point other than the identity is valid.
+func (e *Element[P]) IsValid() bool {
+	return !e.IsIdentity()
+}
+
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 func (e *Element[P]) IsIdentity() bool {
 	b := e.p.BytesCompressed()
@@ -163,6 +191,14 @@ func (e *Element[P]) IsIdentity() bool {
 	return subtle.ConstantTimeCompare(b, i) == 1
 }
 
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it, in constant time.
+func (e *Element[P]) CMov(element internal.Element, choice int) internal.Element {
+	ec := checkElement[P](element)
+	e.p.Select(ec.p, e.p, choice)
+
+	return e
+}
+
 // Set sets the receiver to the value of the argument, and returns the receiver.
 func (e *Element[P]) Set(element internal.Element) internal.Element {
 	if element == nil {
@@ -201,6 +237,8 @@ func encodeInfinity[Point nistECPoint[Point]](element *Element[Point]) []byte {
 	var encodedLength int
 
 	switch err.Error()[:4] {
+	case "P224":
+		encodedLength = p224CompressedEncodingLength
 	case "P256":
 		encodedLength = p256CompressedEncodingLength
 	case "P384":
@@ -214,6 +252,18 @@ func encodeInfinity[Point nistECPoint[Point]](element *Element[Point]) []byte {
 	return make([]byte, encodedLength)
 }
 
+// YCoordinate returns the big-endian encoded affine y coordinate of the element, or zeros for the identity.
+func (e *Element[P]) YCoordinate() []byte {
+	if e.IsIdentity() {
+		inf := encodeInfinity(e)
+		return inf[:len(inf)-1]
+	}
+
+	b := e.p.Bytes()
+
+	return b[1+(len(b)-1)/2:]
+}
+
 // XCoordinate returns the encoded x coordinate of the element.
 func (e *Element[P]) XCoordinate() []byte {
 	if e.IsIdentity() {
@@ -231,10 +281,18 @@ func (e *Element[P]) XCoordinate() []byte {
 
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (e *Element[P]) Decode(data []byte) error {
-	if _, err := e.p.SetBytes(data); err != nil {
+	p, err := e.new().SetBytes(data)
+	if err != nil {
 		return fmt.Errorf("%w", err)
 	}
 
+	// The underlying library decodes a single 0x00 byte to the identity, which is rejected as in the other groups.
+	if subtle.ConstantTimeCompare(p.BytesCompressed(), e.new().BytesCompressed()) == 1 {
+		return fmt.Errorf("invalid point encoding: %w", internal.ErrIdentity)
+	}
+
+	e.p.Set(p)
+
 	return nil
 }
 
@@ -6,7 +6,7 @@
 // LICENSE file in the root directory of this source tree or at
 // https://spdx.org/licenses/MIT.html
 
-// Package nist allows simple and abstracted operations in the NIST P-256, P-384, and
+// Package nist allows simple and abstracted operations in the NIST P-224, P-256, P-384, and
 // P-521 groups, wrapping filippo.io/nistec.
 package nist
 
@@ -23,6 +23,16 @@ import (
 )
 
 const (
+	// H2CP224 represents the hash-to-curve string identifier for P224. This suite is not defined by RFC9380, which has
+	// none for P-224, and so won't interoperate with implementations that don't follow the same choices: it uses the
+	// naming and parameters of the other NIST suites, with Z = 31, the first candidate accepted by the find_z_sswu
+	// procedure of RFC9380 appendix H.2. Z = -31 is rejected by its fourth criterion, since g(B / (Z * A)) is then a
+	// non-square, as is g(0) = B, so the map would have no output for u = 0.
+	H2CP224 = "P224_XMD:SHA-256_SSWU_RO_"
+
+	// E2CP224 represents the encode-to-curve string identifier for P224.
+	E2CP224 = "P224_XMD:SHA-256_SSWU_NU_"
+
 	// H2CP256 represents the hash-to-curve string identifier for P256.
 	H2CP256 = "P256_XMD:SHA-256_SSWU_RO_"
 
@@ -49,8 +59,17 @@ const (
 
 	// IdentifierP521 distinguishes this group from the others by a byte representation.
 	IdentifierP521 = byte(5)
+
+	// IdentifierP224 distinguishes this group from the others by a byte representation.
+	IdentifierP224 = byte(8)
 )
 
+// P224 returns the single instantiation of the P224 Group.
+func P224() internal.Group {
+	initOnceP224.Do(initP224)
+	return &p224
+}
+
 // P256 returns the single instantiation of the P256 Group.
 func P256() internal.Group {
 	initOnceP256.Do(initP256)
@@ -98,6 +117,24 @@ func (g Group[P]) Base() internal.Element {
 	return g.newPoint(b)
 }
 
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar.
+func (g Group[P]) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	s := newScalar(&g.scalarField).assert(scalar)
+	p := g.curve.NewPoint()
+
+	if _, err := p.ScalarBaseMult(s.Encode()); err != nil {
+		panic(err)
+	}
+
+	return g.newPoint(p)
+}
+
+// MapToCurve returns the element the simplified SWU map of the group's hash-to-curve suite sends the field element u
+// to, without hashing, e.g. to test the map on inputs hash_to_field practically never outputs, like 0.
+func (g Group[P]) MapToCurve(u *big.Int) internal.Element {
+	return g.newPoint(g.curve.map2curve(u))
+}
+
 func (g Group[P]) newPoint(p P) *Element[P] {
 	return &Element[P]{
 		p:   p,
@@ -113,7 +150,22 @@ func (g Group[P]) HashFunc() crypto.Hash {
 // HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group[P]) HashToScalar(input, dst []byte) internal.Scalar {
-	s := hash2curve.HashToFieldXMD(g.curve.hash, input, dst, 1, 1, g.curve.secLength, g.scalarField.Order())[0]
+	return g.ScalarFromUniform(hash2curve.ExpandXMD(g.curve.hash, input, dst, g.curve.secLength))
+}
+
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g Group[P]) UniformLengths() (element, scalar uint) {
+	return 2 * g.curve.secLength, g.curve.secLength
+}
+
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g Group[P]) ElementFromUniform(uniform []byte) internal.Element {
+	return g.newPoint(g.curve.fromUniform(uniform))
+}
+
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g Group[P]) ScalarFromUniform(uniform []byte) internal.Scalar {
+	s := internal.ReduceUniform(uniform, 1, g.scalarField.Order())[0]
 
 	// If necessary, build a buffer of right size, so it gets correctly interpreted.
 	bytes := s.Bytes()
@@ -131,12 +183,44 @@ func (g Group[P]) HashToScalar(input, dst []byte) internal.Scalar {
 	return res
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group[P]) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	u := hash2curve.HashToFieldXMD(g.curve.hash, input, dst, count, 1, g.curve.secLength, g.scalarField.Order())
+	out := make([]internal.Scalar, len(u))
+
+	for i, ui := range u {
+		out[i] = newScalar(&g.scalarField).SetBytesReduced(ui.Bytes())
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite.
+func (g Group[P]) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hash2curve.HashToFieldXMD(g.curve.hash, input, dst, count, 1, g.curve.secLength, g.curve.field.Order())
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group[P]) HashToGroup(input, dst []byte) internal.Element {
 	return g.newPoint(g.curve.hashXMD(input, dst))
 }
 
+// BatchHashToGroup returns HashToGroup(input, dst) for each of the inputs, with a single field inversion shared by the
+// maps of all inputs.
+func (g Group[P]) BatchHashToGroup(inputs [][]byte, dst []byte) []internal.Element {
+	points := g.curve.hashXMDBatch(inputs, dst)
+	out := make([]internal.Element, len(points))
+
+	for i, p := range points {
+		out[i] = g.newPoint(p)
+	}
+
+	return out
+}
+
 // EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group[P]) EncodeToGroup(input, dst []byte) internal.Element {
@@ -164,11 +248,26 @@ func (g Group[P]) Order() []byte {
 	return g.scalarField.Order().FillBytes(out)
 }
 
+// FieldPrime returns the big-endian encoding of the order of the base field.
+func (g Group[P]) FieldPrime() []byte {
+	return g.curve.field.Order().FillBytes(make([]byte, g.curve.field.ByteLen()))
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar. The NIST curves have prime order, so it's 1.
+func (g Group[P]) Cofactor() []byte {
+	out := make([]byte, g.scalarField.ByteLen())
+	out[len(out)-1] = 1
+
+	return out
+}
+
 var (
+	initOnceP224 sync.Once
 	initOnceP256 sync.Once
 	initOnceP384 sync.Once
 	initOnceP521 sync.Once
 
+	p224 Group[*nistec.P224Point]
 	p256 Group[*nistec.P256Point]
 	p384 Group[*nistec.P384Point]
 	p521 Group[*nistec.P521Point]
@@ -176,6 +275,18 @@ var (
 	nistWa = field.String2Int("-3")
 )
 
+func initP224() {
+	primeP224, _ := new(big.Int).SetString("26959946667150639794667015087019630673557916260026308143510066298881", 10)
+	p224.h2c = H2CP224
+	p224.curve.setCurveParams(
+		primeP224,
+		"0xb4050a850c04b3abf54132565044b0b7d7bfd8ba270b39432355ffb4",
+		nistec.NewP224Point,
+	)
+	p224.curve.setMapping(crypto.SHA256, "31", 42)
+	setScalarField(&p224, "0xffffffffffffffffffffffffffff16a2e0b8f03e13dd29455c5c2a3d")
+}
+
 func initP256() {
 	primeP256, _ := new(big.Int).SetString("115792089210356248762697446949407573530"+
 		"086143415290314195533631308867097853951", 10)
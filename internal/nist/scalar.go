@@ -50,6 +50,8 @@ func (s *Scalar) assert(scalar internal.Scalar) *Scalar {
 // Group returns the group's Identifier.
 func (s *Scalar) Group() byte {
 	switch *s.field {
+	case p224.scalarField:
+		return IdentifierP224
 	case p256.scalarField:
 		return IdentifierP256
 	case p384.scalarField:
@@ -127,25 +129,56 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+func (s *Scalar) MultiplyAdd(a, b internal.Scalar) internal.Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	sa := s.assert(a)
+	sb := s.assert(b)
+
+	// Use an intermediate value in case b aliases the receiver.
+	var product big.Int
+	product.Mul(&s.scalar, &sa.scalar)
+	s.field.Add(&s.scalar, &product, &sb.scalar)
+
+	return s
+}
+
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
 func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
-	if scalar == nil || scalar.IsZero() {
+	if scalar == nil {
+		return s.One()
+	}
+
+	sc := s.assert(scalar)
+
+	if sc.IsZero() {
 		return s.One()
 	}
 
-	if scalar.Equal(newScalar(s.field).One()) == 1 {
+	if s.IsZero() {
 		return s
 	}
 
-	sc := s.assert(scalar)
+	if sc.Equal(newScalar(s.field).One()) == 1 {
+		return s
+	}
 	s.field.Exponent(&s.scalar, &s.scalar, &sc.scalar)
 
 	return s
 }
 
-// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it.
+// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it. It runs in constant time, exponentiating
+// by order - 2 with a fixed addition chain, and the inverse of 0 is 0.
 func (s *Scalar) Invert() internal.Scalar {
-	s.field.Inv(&s.scalar, &s.scalar)
+	s.field.InvConstantTime(&s.scalar, &s.scalar)
 	return s
 }
 
@@ -185,6 +218,17 @@ func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	return 1
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := s.assert(scalar)
+	return internal.CompareBigEndian(s.Encode(), sc.Encode())
+}
+
+// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return internal.BitBigEndian(s.Encode(), i)
+}
+
 // IsZero returns whether the scalar is 0.
 func (s *Scalar) IsZero() bool {
 	return s.field.IsZero(&s.scalar)
@@ -240,6 +284,12 @@ func (s *Scalar) Encode() []byte {
 	return s.scalar.FillBytes(scalar)
 }
 
+// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetBytesReduced(in []byte) internal.Scalar {
+	s.scalar.Set(s.field.Mod(new(big.Int).SetBytes(in)))
+	return s
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
 	switch len(in) {
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package pasta
+
+import (
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+var (
+	// curveB is b in the curve equation y^2 = x^3 + 5 shared by Pallas and Vesta, and b3 = 3 * b.
+	curveB = big.NewInt(5)
+	b3     = big.NewInt(15)
+)
+
+// curve holds the base field arithmetic of a Pasta curve y^2 = x^3 + 5, and the parameters of its hash-to-curve map.
+type curve struct {
+	field field.Field
+	base  *point
+
+	// isoA and isoB are the parameters of the curve E' 3-isogenous to the curve, y^2 = x^3 + isoA * x + isoB, to which
+	// the simplified SWU method maps, and mapZ is its Z parameter.
+	isoA, isoB, mapZ *big.Int
+
+	// The coefficients of the 3-isogeny map from E' to the curve, in ascending degree. The leading coefficients of the
+	// denominators, 1, are omitted.
+	isoXNum, isoXDen, isoYNum, isoYDen []*big.Int
+}
+
+func (c *curve) mul(x, y *big.Int) *big.Int {
+	return c.field.Mod(new(big.Int).Mul(x, y))
+}
+
+func (c *curve) add(x, y *big.Int) *big.Int {
+	return c.field.Mod(new(big.Int).Add(x, y))
+}
+
+func (c *curve) sub(x, y *big.Int) *big.Int {
+	return c.field.Mod(new(big.Int).Sub(x, y))
+}
+
+func (c *curve) neg(x *big.Int) *big.Int {
+	return c.field.Mod(new(big.Int).Neg(x))
+}
+
+func (c *curve) invert(x *big.Int) *big.Int {
+	res := new(big.Int)
+	c.field.Inv(res, x)
+
+	return res
+}
+
+// sqrt returns a square root of x and true if x is a square, and false otherwise. The Pasta fields have a 2-adicity
+// of 32, so this relies on the Tonelli-Shanks implementation of big.Int.
+func (c *curve) sqrt(x *big.Int) (*big.Int, bool) {
+	r := new(big.Int).ModSqrt(x, c.field.Order())
+	if r == nil {
+		return nil, false
+	}
+
+	return r, true
+}
+
+// rhs returns x^3 + 5, the right-hand side of the curve equation.
+func (c *curve) rhs(x *big.Int) *big.Int {
+	return c.add(c.mul(c.mul(x, x), x), curveB)
+}
+
+// point is a point of a Pasta curve in homogeneous projective coordinates, with x = X/Z and y = Y/Z, and where the
+// identity is (0:1:0).
+type point struct {
+	x, y, z *big.Int
+}
+
+func identity() *point {
+	return &point{x: new(big.Int), y: big.NewInt(1), z: new(big.Int)}
+}
+
+func newAffinePoint(x, y *big.Int) *point {
+	return &point{x: x, y: y, z: big.NewInt(1)}
+}
+
+func (p *point) copy() *point {
+	return &point{
+		x: new(big.Int).Set(p.x),
+		y: new(big.Int).Set(p.y),
+		z: new(big.Int).Set(p.z),
+	}
+}
+
+func (p *point) isIdentity() bool {
+	return p.z.Sign() == 0
+}
+
+// addPoints returns p + q, using the complete addition formulas for a = 0 short Weierstrass curves of Renes, Costello,
+// and Batina (Algorithm 7 in https://eprint.iacr.org/2015/1060), which also hold for doubling and the identity.
+func (c *curve) addPoints(p, q *point) *point {
+	t0 := c.mul(p.x, q.x)
+	t1 := c.mul(p.y, q.y)
+	t2 := c.mul(p.z, q.z)
+	t3 := c.sub(c.mul(c.add(p.x, p.y), c.add(q.x, q.y)), c.add(t0, t1))
+	t4 := c.sub(c.mul(c.add(p.y, p.z), c.add(q.y, q.z)), c.add(t1, t2))
+	y3 := c.sub(c.mul(c.add(p.x, p.z), c.add(q.x, q.z)), c.add(t0, t2))
+	t0 = c.mul(big.NewInt(3), t0)
+	t2 = c.mul(b3, t2)
+	z3 := c.add(t1, t2)
+	t1 = c.sub(t1, t2)
+	y3 = c.mul(b3, y3)
+
+	return &point{
+		x: c.sub(c.mul(t3, t1), c.mul(t4, y3)),
+		y: c.add(c.mul(t1, z3), c.mul(y3, t0)),
+		z: c.add(c.mul(z3, t4), c.mul(t0, t3)),
+	}
+}
+
+func (c *curve) negate(p *point) *point {
+	return &point{x: new(big.Int).Set(p.x), y: c.neg(p.y), z: new(big.Int).Set(p.z)}
+}
+
+// equal returns whether p and q are the same point, i.e. whether X1 * Z2 == X2 * Z1 and Y1 * Z2 == Y2 * Z1.
+func (c *curve) equal(p, q *point) bool {
+	return c.mul(p.x, q.z).Cmp(c.mul(q.x, p.z)) == 0 && c.mul(p.y, q.z).Cmp(c.mul(q.y, p.z)) == 0
+}
+
+// affine returns the affine coordinates of p, and (0, 0) for the identity.
+func (c *curve) affine(p *point) (x, y *big.Int) {
+	if p.z.Cmp(big.NewInt(1)) == 0 {
+		return new(big.Int).Set(p.x), new(big.Int).Set(p.y)
+	}
+
+	zInv := c.invert(p.z)
+
+	return c.mul(p.x, zInv), c.mul(p.y, zInv)
+}
+
+// normalize sets the points to their representation with Z = 1, with a single inversion shared by all of them. The
+// identity is left as is.
+func (c *curve) normalize(points []*point) {
+	zInv := make([]*big.Int, len(points))
+	for i, p := range points {
+		zInv[i] = new(big.Int).Set(p.z)
+	}
+
+	c.field.BatchInv(zInv)
+
+	for i, p := range points {
+		if p.isIdentity() {
+			continue
+		}
+
+		p.x, p.y, p.z = c.mul(p.x, zInv[i]), c.mul(p.y, zInv[i]), big.NewInt(1)
+	}
+}
+
+// multiplyWindow is the width, in bits, of the scalar digits in point multiplication.
+const multiplyWindow = 4
+
+// multiply returns s * p, with a fixed window over the big-endian encoded scalar s of any length. The table is indexed by
+// the digits of s, which may leak them through memory access timing, on top of the variable-time big.Int arithmetic.
+func (c *curve) multiply(s []byte, p *point) *point {
+	// table[d] = d * p, for 0 <= d < 2^multiplyWindow.
+	table := make([]*point, 1<<multiplyWindow)
+	table[0] = identity()
+
+	for d := 1; d < len(table); d++ {
+		table[d] = c.addPoints(table[d-1], p)
+	}
+
+	result := identity()
+
+	for _, b := range s {
+		for _, digit := range []byte{b >> multiplyWindow, b & (1<<multiplyWindow - 1)} {
+			for range multiplyWindow {
+				result = c.addPoints(result, result)
+			}
+
+			result = c.addPoints(result, table[digit])
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package pasta
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// Element implements the Element interface for the Pasta group elements.
+type Element struct {
+	group *Group
+	point *point
+}
+
+func (e *Element) check(element internal.Element) *Element {
+	if element == nil {
+		panic(internal.ErrParamNilPoint)
+	}
+
+	ec, ok := element.(*Element)
+	if !ok || ec.group.identifier != e.group.identifier {
+		panic(internal.ErrCastElement)
+	}
+
+	return ec
+}
+
+// encode returns the compressed encoding of p, as in the pasta_curves crate: the 32-byte little-endian x coordinate,
+// with the parity of y in the most significant bit. The identity is encoded as all zeros, which is unambiguous as 5
+// is not a square in either field and x = 0 is thus not on the curves.
+func (c *curve) encode(p *point) []byte {
+	if p.isIdentity() {
+		return make([]byte, scalarLength)
+	}
+
+	x, y := c.affine(p)
+	out := internal.Reverse(x.FillBytes(make([]byte, scalarLength)))
+	out[scalarLength-1] |= byte(y.Bit(0)) << 7
+
+	return out
+}
+
+// decode returns the point encoded in data, which must be canonical, and the identity for the all-zero encoding.
+func (c *curve) decode(data []byte) (*point, error) {
+	if len(data) != scalarLength {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	if subtle.ConstantTimeCompare(data, make([]byte, scalarLength)) == 1 {
+		return identity(), nil
+	}
+
+	sign := uint(data[scalarLength-1] >> 7)
+	encoded := internal.Reverse(data)
+	encoded[0] &= 0x7f
+
+	x := new(big.Int).SetBytes(encoded)
+	if x.Cmp(c.field.Order()) >= 0 {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	y, ok := c.sqrt(c.rhs(x))
+	if !ok {
+		return nil, internal.ErrParamInvalidPointEncoding
+	}
+
+	if y.Bit(0) != sign {
+		y = c.neg(y)
+	}
+
+	return newAffinePoint(x, y), nil
+}
+
+// Group returns the group's Identifier.
+func (e *Element) Group() byte {
+	return e.group.identifier
+}
+
+// Base sets the element to the group's base point a.k.a. canonical generator.
+func (e *Element) Base() internal.Element {
+	e.point = e.group.base.copy()
+	return e
+}
+
+// Identity sets the element to the point at infinity of the Group's underlying curve.
+func (e *Element) Identity() internal.Element {
+	e.point = identity()
+	return e
+}
+
+// Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
+func (e *Element) Add(element internal.Element) internal.Element {
+	ec := e.check(element)
+	e.point = e.group.addPoints(e.point, ec.point)
+
+	return e
+}
+
+// Double sets the receiver to its double, and returns it.
+func (e *Element) Double() internal.Element {
+	e.point = e.group.addPoints(e.point, e.point)
+	return e
+}
+
+// Negate sets the receiver to its negation, and returns it.
+func (e *Element) Negate() internal.Element {
+	e.point = e.group.negate(e.point)
+	return e
+}
+
+// Subtract subtracts the input from the receiver, and returns the receiver.
+func (e *Element) Subtract(element internal.Element) internal.Element {
+	ec := e.check(element)
+	e.point = e.group.addPoints(e.point, e.group.negate(ec.point))
+
+	return e
+}
+
+// Multiply sets the receiver to the scalar multiplication of the receiver with the given Scalar, and returns it.
+func (e *Element) Multiply(scalar internal.Scalar) internal.Element {
+	if scalar == nil {
+		return e.Identity()
+	}
+
+	sc := e.group.newScalar().assert(scalar)
+	e.point = e.group.multiply(sc.scalar.FillBytes(make([]byte, scalarLength)), e.point)
+
+	return e
+}
+
+// Equal returns 1 if the elements are equivalent, and 0 otherwise.
+func (e *Element) Equal(element internal.Element) int {
+	ec := e.check(element)
+	if e.group.equal(e.point, ec.point) {
+		return 1
+	}
+
+	return 0
+}
+
+// ClearCofactor is a no-op, as the Pasta curves have prime order, and returns the receiver.
+func (e *Element) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsValid returns whether the element is not the identity. The Pasta curves have prime order, so every other point
+// on the curve is in the group.
+func (e *Element) IsValid() bool {
+	return !e.IsIdentity()
+}
+
+// IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
+func (e *Element) IsIdentity() bool {
+	return e.point.isIdentity()
+}
+
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it. The selection
+// is done on the fixed-size encodings of the coordinates without branching on choice, but the big.Int based arithmetic
+// of this backend is not constant time.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := e.check(element)
+	dst := []*big.Int{e.point.x, e.point.y, e.point.z}
+	src := []*big.Int{ec.point.x, ec.point.y, ec.point.z}
+	p := make([]*big.Int, len(dst))
+
+	for i := range dst {
+		coordinate := dst[i].FillBytes(make([]byte, scalarLength))
+		subtle.ConstantTimeCopy(choice, coordinate, src[i].FillBytes(make([]byte, scalarLength)))
+		p[i] = new(big.Int).SetBytes(coordinate)
+	}
+
+	e.point = &point{x: p[0], y: p[1], z: p[2]}
+
+	return e
+}
+
+// Set sets the receiver to the value of the argument, and returns the receiver.
+func (e *Element) Set(element internal.Element) internal.Element {
+	if element == nil {
+		return e.Identity()
+	}
+
+	ec := e.check(element)
+	e.point = ec.point.copy()
+
+	return e
+}
+
+// Copy returns a copy of the receiver.
+func (e *Element) Copy() internal.Element {
+	return &Element{group: e.group, point: e.point.copy()}
+}
+
+// Encode returns the compressed byte encoding of the element.
+func (e *Element) Encode() []byte {
+	return e.group.encode(e.point)
+}
+
+// XCoordinate returns the little-endian encoded affine x coordinate of the element, and zeros for the identity.
+func (e *Element) XCoordinate() []byte {
+	x, _ := e.group.affine(e.point)
+	return internal.Reverse(x.FillBytes(make([]byte, scalarLength)))
+}
+
+// YCoordinate returns the little-endian encoded affine y coordinate of the element, and zeros for the identity.
+func (e *Element) YCoordinate() []byte {
+	_, y := e.group.affine(e.point)
+	return internal.Reverse(y.FillBytes(make([]byte, scalarLength)))
+}
+
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+func (e *Element) Decode(data []byte) error {
+	p, err := e.group.decode(data)
+	if err != nil {
+		return fmt.Errorf("invalid %s encoding: %w", e.group.name, err)
+	}
+
+	if p.isIdentity() {
+		return fmt.Errorf("invalid %s encoding: %w", e.group.name, internal.ErrIdentity)
+	}
+
+	e.point = p
+
+	return nil
+}
+
+// Hex returns the fixed-sized hexadecimal encoding of e.
+func (e *Element) Hex() string {
+	return hex.EncodeToString(e.Encode())
+}
+
+// DecodeHex sets e to the decoding of the hex encoded element.
+func (e *Element) DecodeHex(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	return e.Decode(b)
+}
@@ -0,0 +1,304 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package pasta allows simple and abstracted operations in the Pallas and Vesta groups of the Pasta cycle of
+// curves, whose encodings follow the pasta_curves crate. Its field and curve arithmetic is implemented here with
+// big.Int, is not constant time, and has not been audited.
+package pasta
+
+import (
+	"crypto"
+	"math/big"
+	"sync"
+
+	"github.com/0xBridge/hash2curve"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+const (
+	// H2CPallas represents the hash-to-curve string identifier for Pallas. RFC9380 doesn't define a suite for the
+	// Pasta curves, so this follows its naming, and maps with the simplified SWU method to the 3-isogenous curve and
+	// Z = -13 of the pasta_curves crate.
+	H2CPallas = "pallas_XMD:SHA-256_SSWU_RO_"
+
+	// E2CPallas represents the encode-to-curve string identifier for Pallas.
+	E2CPallas = "pallas_XMD:SHA-256_SSWU_NU_"
+
+	// H2CVesta represents the hash-to-curve string identifier for Vesta, following the same conventions as H2CPallas.
+	H2CVesta = "vesta_XMD:SHA-256_SSWU_RO_"
+
+	// E2CVesta represents the encode-to-curve string identifier for Vesta.
+	E2CVesta = "vesta_XMD:SHA-256_SSWU_NU_"
+
+	// IdentifierPallas distinguishes this group from the others by a byte representation.
+	IdentifierPallas = byte(11)
+
+	// IdentifierVesta distinguishes this group from the others by a byte representation.
+	IdentifierVesta = byte(12)
+)
+
+var (
+	// pallasField is the base field of Pallas, and the scalar field of Vesta.
+	pallasField = field.String2Int("0x40000000000000000000000000000000224698fc094cf91b992d30ed00000001")
+
+	// vestaField is the base field of Vesta, and the scalar field of Pallas.
+	vestaField = field.String2Int("0x40000000000000000000000000000000224698fc0994a8dd8c46eb2100000001")
+
+	// pallasIsoA is the A parameter of the curve 3-isogenous to Pallas.
+	pallasIsoA = field.String2Int("0x18354a2eb0ea8c9c49be2d7258370742b74134581a27a59f92bb4b0b657a014b")
+
+	// vestaIsoA is the A parameter of the curve 3-isogenous to Vesta.
+	vestaIsoA = field.String2Int("0x267f9b2ee592271a81639c4d96f787739673928c7d01b212c515ad7242eaa6b1")
+
+	initOncePallas sync.Once
+	pallas         Group
+
+	initOnceVesta sync.Once
+	vesta         Group
+)
+
+// Pallas returns the single instantiation of the Pallas Group.
+func Pallas() internal.Group {
+	initOncePallas.Do(initPallas)
+	return &pallas
+}
+
+// Vesta returns the single instantiation of the Vesta Group.
+func Vesta() internal.Group {
+	initOnceVesta.Do(initVesta)
+	return &vesta
+}
+
+func initPallas() {
+	pallas = Group{
+		curve: curve{
+			field: field.NewField(&pallasField),
+			isoA:  &pallasIsoA,
+			isoB:  big.NewInt(1265),
+			mapZ:  big.NewInt(-13),
+			isoXNum: stringsToInts(
+				"0x1c71c71c71c71c71c71c71c71c71c71c8102eea8e7b06eb6eebec06955555580",
+				"0x17329b9ec525375398c7d7ac3d98fd13380af066cfeb6d690eb64faef37ea4f7",
+				"0x3509afd51872d88e267c7ffa51cf412a0f93b82ee4b994958cf863b02814fb76",
+				"0x0e38e38e38e38e38e38e38e38e38e38e4081775473d8375b775f6034aaaaaaab",
+			),
+			isoXDen: stringsToInts(
+				"0x325669becaecd5d11d13bf2a7f22b105b4abf9fb9a1fc81c2aa3af1eae5b6604",
+				"0x1d572e7ddc099cff5a607fcce0494a799c434ac1c96b6980c47f2ab668bcd71f",
+			),
+			isoYNum: stringsToInts(
+				"0x025ed097b425ed097b425ed097b425ed0ac03e8e134eb3e493e53ab371c71c4f",
+				"0x3fb98ff0d2ddcadd303216cce1db9ff11765e924f745937802e2be87d225b234",
+				"0x1a84d7ea8c396c47133e3ffd28e7a09507c9dc17725cca4ac67c31d8140a7dbb",
+				"0x1a12f684bda12f684bda12f684bda12f7642b01ad461bad25ad985b5e38e38e4",
+			),
+			isoYDen: stringsToInts(
+				"0x40000000000000000000000000000000224698fc094cf91b992d30ecfffffde5",
+				"0x17033d3c60c68173573b3d7f7d681310d976bbfabbc5661d4d90ab820b12320a",
+				"0x0c02c5bcca0e6b7f0790bfb3506defb65941a3a4a97aa1b35a28279b1d1b42ae",
+			),
+		},
+		scalarField: field.NewField(&vestaField),
+		name:        "Pallas",
+		h2c:         H2CPallas,
+		e2c:         E2CPallas,
+		identifier:  IdentifierPallas,
+	}
+
+	// The generator of both curves is (-1, 2).
+	pallas.base = newAffinePoint(pallas.field.PMinusOne(), big.NewInt(2))
+}
+
+func initVesta() {
+	vesta = Group{
+		curve: curve{
+			field: field.NewField(&vestaField),
+			isoA:  &vestaIsoA,
+			isoB:  big.NewInt(1265),
+			mapZ:  big.NewInt(-13),
+			isoXNum: stringsToInts(
+				"0x31c71c71c71c71c71c71c71c71c71c71e1c521a795ac8356fb539a6f0000002b",
+				"0x18760c7f7a9ad20ded7ee4a9cdf78f8fd59d03d23b39cb11aeac67bbeb586a3d",
+				"0x1d935247b4473d17acecf10f5f7c09a2216b8861ec72bd5d8b95c6aaf703bcc5",
+				"0x38e38e38e38e38e38e38e38e38e38e390205dd51cfa0961a43cd42c800000001",
+			),
+			isoXDen: stringsToInts(
+				"0x14735171ee5427780c621de8b91c242a30cd6d53df49d235f169c187d2533465",
+				"0x0a2de485568125d51454798a5b5c56b2a3ad678129b604d3b7284f7eaf21a2e9",
+			),
+			isoYNum: stringsToInts(
+				"0x1ed097b425ed097b425ed097b425ed098bc32d36fb21a6a38f64842c55555533",
+				"0x19b0d87e16e2578866d1466e9de10e6497a3ca5c24e9ea634986913ab4443034",
+				"0x2ec9a923da239e8bd6767887afbe04d121d910aefb03b31d8bee58e5fb81de63",
+				"0x12f684bda12f684bda12f684bda12f685601f4709a8adcb36bef1642aaaaaaab",
+			),
+			isoYDen: stringsToInts(
+				"0x40000000000000000000000000000000224698fc0994a8dd8c46eb20fffffde5",
+				"0x3d59f455cafc7668252659ba2b546c7e926847fb9ddd76a1d43d449776f99d2f",
+				"0x2f44d6c801c1b8bf9e7eb64f890a820c06a767bfc35b5bac58dfecce86b2745e",
+			),
+		},
+		scalarField: field.NewField(&pallasField),
+		name:        "Vesta",
+		h2c:         H2CVesta,
+		e2c:         E2CVesta,
+		identifier:  IdentifierVesta,
+	}
+
+	vesta.base = newAffinePoint(vesta.field.PMinusOne(), big.NewInt(2))
+}
+
+// Group represents a Pasta group. It exposes a prime-order group API with hash-to-curve operations. This implementation
+// relies on big.Int arithmetic, and is therefore not constant time.
+type Group struct {
+	curve
+	scalarField field.Field
+	name        string
+	h2c         string
+	e2c         string
+	identifier  byte
+}
+
+func (g *Group) newScalar() *Scalar {
+	return newScalar(g.identifier, &g.scalarField)
+}
+
+func (g *Group) newElement(p *point) *Element {
+	return &Element{group: g, point: p}
+}
+
+// NewScalar returns a new scalar set to 0.
+func (g *Group) NewScalar() internal.Scalar {
+	return g.newScalar()
+}
+
+// NewElement returns the identity element (point at infinity).
+func (g *Group) NewElement() internal.Element {
+	return g.newElement(identity())
+}
+
+// Base returns the group's base point a.k.a. canonical generator.
+func (g *Group) Base() internal.Element {
+	return g.newElement(g.base.copy())
+}
+
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar.
+func (g *Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	return g.Base().Multiply(scalar)
+}
+
+// HashFunc returns SHA2-256, the hash function of the group's hash-to-curve suite.
+func (g *Group) HashFunc() crypto.Hash {
+	return crypto.SHA256
+}
+
+// HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g *Group) HashToScalar(input, dst []byte) internal.Scalar {
+	return g.ScalarFromUniform(hash2curve.ExpandXMD(crypto.SHA256, input, dst, secLength))
+}
+
+// BatchNormalize sets the elements to their affine representation, with a single field inversion shared by all of
+// them.
+func (g *Group) BatchNormalize(elements []internal.Element) {
+	e := g.newElement(identity())
+	points := make([]*point, len(elements))
+
+	for i, element := range elements {
+		points[i] = e.check(element).point
+	}
+
+	g.normalize(points)
+}
+
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g *Group) UniformLengths() (element, scalar uint) {
+	return 2 * secLength, secLength
+}
+
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g *Group) ElementFromUniform(uniform []byte) internal.Element {
+	return g.newElement(g.fromUniform(uniform))
+}
+
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g *Group) ScalarFromUniform(uniform []byte) internal.Scalar {
+	s := g.newScalar()
+	s.scalar.Set(internal.ReduceUniform(uniform, 1, g.scalarField.Order())[0])
+
+	return s
+}
+
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g *Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	u := hashToField(input, dst, count, g.scalarField.Order())
+	out := make([]internal.Scalar, len(u))
+
+	for i, ui := range u {
+		s := g.newScalar()
+		s.scalar.Set(ui)
+		out[i] = s
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite.
+func (g *Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hashToField(input, dst, count, g.field.Order())
+}
+
+// HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g *Group) HashToGroup(input, dst []byte) internal.Element {
+	return g.newElement(g.hashToCurve(input, dst))
+}
+
+// EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
+// The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g *Group) EncodeToGroup(input, dst []byte) internal.Element {
+	return g.newElement(g.encodeToCurve(input, dst))
+}
+
+// Ciphersuite returns the hash-to-curve ciphersuite identifier.
+func (g *Group) Ciphersuite() string {
+	return g.h2c
+}
+
+// ScalarLength returns the byte size of an encoded scalar.
+func (g *Group) ScalarLength() int {
+	return scalarLength
+}
+
+// ElementLength returns the byte size of an encoded element.
+func (g *Group) ElementLength() int {
+	return scalarLength
+}
+
+// Order returns the order of the canonical group of scalars.
+func (g *Group) Order() []byte {
+	return internal.Reverse(g.scalarField.Order().FillBytes(make([]byte, scalarLength)))
+}
+
+// FieldPrime returns the big-endian encoding of the order of the base field, which is the order of the scalar field of
+// the other curve of the cycle.
+func (g *Group) FieldPrime() []byte {
+	return g.field.Order().FillBytes(make([]byte, scalarLength))
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar. Pallas and Vesta have prime order, so it's 1.
+func (g *Group) Cofactor() []byte {
+	out := make([]byte, scalarLength)
+	out[0] = 1
+
+	return out
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package pasta
+
+import (
+	"crypto"
+	"math/big"
+
+	"github.com/0xBridge/hash2curve"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+// secLength is the expansion length L of hash_to_field, to the base and scalar fields, for a security level of k = 128
+// bits.
+const secLength = 48
+
+func stringsToInts(s ...string) []*big.Int {
+	ints := make([]*big.Int, len(s))
+
+	for i, v := range s {
+		n := field.String2Int(v)
+		ints[i] = &n
+	}
+
+	return ints
+}
+
+// sgn0 returns the parity of x, as specified in RFC 9380.
+func sgn0(x *big.Int) uint {
+	return x.Bit(0)
+}
+
+// mapToIsoCurve maps the field element u to a point (x, y) of E', using the simplified SWU method.
+func (c *curve) mapToIsoCurve(u *big.Int) (x, y *big.Int) {
+	// tv1 = 1 / (Z^2 * u^4 + Z * u^2)
+	zu2 := c.mul(c.mapZ, c.mul(u, u))
+	tv1 := c.invert(c.add(c.mul(zu2, zu2), zu2))
+
+	// x1 = (-B / A) * (1 + tv1), and x1 = B / (Z * A) on exceptional cases.
+	var x1 *big.Int
+	if tv1.Sign() == 0 {
+		x1 = c.mul(c.isoB, c.invert(c.mul(c.mapZ, c.isoA)))
+	} else {
+		x1 = c.mul(c.mul(c.neg(c.isoB), c.invert(c.isoA)), c.add(big.NewInt(1), tv1))
+	}
+
+	x = x1
+
+	y, ok := c.sqrt(c.isoCurveRHS(x1))
+	if !ok {
+		x = c.mul(zu2, x1)
+		y, _ = c.sqrt(c.isoCurveRHS(x))
+	}
+
+	if sgn0(u) != sgn0(y) {
+		y = c.neg(y)
+	}
+
+	return x, y
+}
+
+// isoCurveRHS returns x^3 + A' * x + B', the right-hand side of the equation of E'.
+func (c *curve) isoCurveRHS(x *big.Int) *big.Int {
+	return c.add(c.add(c.mul(c.mul(x, x), x), c.mul(c.isoA, x)), c.isoB)
+}
+
+// polynomial evaluates the polynomial with the given coefficients in ascending degree at x, adding a leading
+// coefficient of 1 if monic is true.
+func (c *curve) polynomial(coefficients []*big.Int, x *big.Int, monic bool) *big.Int {
+	res := new(big.Int)
+	if monic {
+		res.SetInt64(1)
+	}
+
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		res = c.add(c.mul(res, x), coefficients[i])
+	}
+
+	return res
+}
+
+// isogeny maps the point (x, y) of E' to the curve with the 3-isogeny. Points for which a denominator is zero are
+// mapped to the identity.
+func (c *curve) isogeny(x, y *big.Int) *point {
+	xDen := c.polynomial(c.isoXDen, x, true)
+	yDen := c.polynomial(c.isoYDen, x, true)
+
+	if xDen.Sign() == 0 || yDen.Sign() == 0 {
+		return identity()
+	}
+
+	return newAffinePoint(
+		c.mul(c.polynomial(c.isoXNum, x, false), c.invert(xDen)),
+		c.mul(y, c.mul(c.polynomial(c.isoYNum, x, false), c.invert(yDen))),
+	)
+}
+
+// mapToCurve maps the field element u to a point of the curve. The curves have prime order, so there is no cofactor
+// to clear.
+func (c *curve) mapToCurve(u *big.Int) *point {
+	return c.isogeny(c.mapToIsoCurve(u))
+}
+
+func hashToField(input, dst []byte, count uint, modulo *big.Int) []*big.Int {
+	return hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, count, 1, secLength, modulo)
+}
+
+// hashToCurve implements the hash_to_curve function of the curve's RO suite.
+func (c *curve) hashToCurve(input, dst []byte) *point {
+	return c.fromUniform(hash2curve.ExpandXMD(crypto.SHA256, input, dst, 2*secLength))
+}
+
+// fromUniform returns the point hashToCurve maps the uniform bytes of its expansion to.
+func (c *curve) fromUniform(uniform []byte) *point {
+	u := internal.ReduceUniform(uniform, 2, c.field.Order())
+	return c.addPoints(c.mapToCurve(u[0]), c.mapToCurve(u[1]))
+}
+
+// encodeToCurve implements the encode_to_curve function of the curve's NU suite.
+func (c *curve) encodeToCurve(input, dst []byte) *point {
+	u := hashToField(input, dst, 1, c.field.Order())
+	return c.mapToCurve(u[0])
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package rfc6979 implements the deterministic nonce generation of RFC 6979.
+package rfc6979
+
+import (
+	"crypto/hmac"
+	"hash"
+	"math/big"
+)
+
+// Generator is the HMAC_DRBG based generator of RFC 6979 section 3.2.
+type Generator struct {
+	hash  func() hash.Hash
+	order *big.Int
+	k, v  []byte
+}
+
+// New returns the generator of the nonces for the secret key x and the message digest h1, as int2octets(x) and
+// bits2octets(h1) encodings, with the additional data extra of section 3.6, which may be nil.
+func New(h func() hash.Hash, order *big.Int, x, h1, extra []byte) *Generator {
+	size := h().Size()
+	g := &Generator{
+		hash:  h,
+		order: order,
+		k:     make([]byte, size),
+		v:     make([]byte, size),
+	}
+
+	for i := range g.v {
+		g.v[i] = 0x01
+	}
+
+	g.k = g.mac(g.v, []byte{0x00}, x, h1, extra)
+	g.v = g.mac(g.v)
+	g.k = g.mac(g.v, []byte{0x01}, x, h1, extra)
+	g.v = g.mac(g.v)
+
+	return g
+}
+
+func (g *Generator) mac(data ...[]byte) []byte {
+	m := hmac.New(g.hash, g.k)
+	for _, d := range data {
+		_, _ = m.Write(d)
+	}
+
+	return m.Sum(nil)
+}
+
+// Next returns the next nonce candidate in [1, order - 1].
+func (g *Generator) Next() *big.Int {
+	rlen := (g.order.BitLen() + 7) / 8
+
+	for {
+		t := make([]byte, 0, rlen)
+		for len(t) < rlen {
+			g.v = g.mac(g.v)
+			t = append(t, g.v...)
+		}
+
+		k := Bits2Int(g.order, t[:rlen])
+
+		// Prepare the state for the next candidate, in case this one is out of range or rejected by the caller.
+		g.k = g.mac(g.v, []byte{0x00})
+		g.v = g.mac(g.v)
+
+		if k.Sign() > 0 && k.Cmp(g.order) < 0 {
+			return k
+		}
+	}
+}
+
+// Bits2Int returns the integer of the leftmost bits of the digest, as many as the bit length of the order
+// (section 2.3.2).
+func Bits2Int(order *big.Int, digest []byte) *big.Int {
+	qlen := order.BitLen()
+	i := new(big.Int).SetBytes(digest)
+
+	if excess := 8*len(digest) - qlen; excess > 0 {
+		i.Rsh(i, uint(excess))
+	}
+
+	return i
+}
+
+// Int2Octets returns the big-endian encoding of x over the byte length of the order (section 2.3.3).
+func Int2Octets(order, x *big.Int) []byte {
+	return x.FillBytes(make([]byte, (order.BitLen()+7)/8))
+}
+
+// Bits2Octets returns the encoding of the digest reduced modulo the order (section 2.3.4).
+func Bits2Octets(order *big.Int, digest []byte) []byte {
+	z := Bits2Int(order, digest)
+	if z.Cmp(order) >= 0 {
+		z.Sub(z, order)
+	}
+
+	return Int2Octets(order, z)
+}
@@ -10,6 +10,7 @@
 package ristretto
 
 import (
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 
@@ -100,6 +101,18 @@ func (e *Element) Equal(element internal.Element) int {
 	return e.element.Equal(&ec.element)
 }
 
+// ClearCofactor sets the receiver to its product with the group's cofactor, and returns it. Ristretto255 is a
+// prime-order group abstracting away the cofactor of the underlying curve, so this is a no-op.
+func (e *Element) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order group. Ristretto255 elements are
+// always in the prime-order group, so any element other than the identity is valid.
+func (e *Element) IsValid() bool {
+	return !e.IsIdentity()
+}
+
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 func (e *Element) IsIdentity() bool {
 	id := ristretto255.NewElement().Zero()
@@ -111,6 +124,22 @@ func (e *Element) set(element *Element) *Element {
 	return e
 }
 
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it, in constant time.
+// The library doesn't expose a conditional selection, so the selection is done on the canonical encodings, which are
+// encoded and decoded in constant time.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := checkElement(element)
+	encoded := e.element.Encode(nil)
+	subtle.ConstantTimeCopy(choice, encoded, ec.element.Encode(nil))
+
+	// The encoding of a valid element, including the identity, always decodes.
+	if err := e.element.Decode(encoded); err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
 // Set sets the receiver to the value of the argument, and returns the receiver.
 func (e *Element) Set(element internal.Element) internal.Element {
 	if element == nil {
@@ -127,12 +156,7 @@ func (e *Element) Set(element internal.Element) internal.Element {
 
 // Copy returns a copy of the receiver.
 func (e *Element) Copy() internal.Element {
-	n := ristretto255.NewElement()
-	if err := n.Decode(e.element.Encode(nil)); err != nil {
-		panic(err)
-	}
-
-	return &Element{element: *n}
+	return &Element{element: e.element}
 }
 
 // Encode returns the compressed byte encoding of the element.
@@ -140,6 +164,11 @@ func (e *Element) Encode() []byte {
 	return e.element.Encode(nil)
 }
 
+// YCoordinate returns nil, as Ristretto255 elements are equivalence classes of points and have no coordinates.
+func (e *Element) YCoordinate() []byte {
+	return nil
+}
+
 // XCoordinate returns the encoded x coordinate of the element, which is the same as Encode().
 func (e *Element) XCoordinate() []byte {
 	return e.Encode()
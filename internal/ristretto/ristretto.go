@@ -11,6 +11,7 @@ package ristretto
 
 import (
 	"crypto"
+	"math/big"
 	"slices"
 
 	"github.com/0xBridge/hash2curve"
@@ -25,6 +26,9 @@ const (
 
 	inputLength = 64
 
+	// fieldSecLength is the expansion length L of hash_to_field, for a security level of k = 128 bits.
+	fieldSecLength = 48
+
 	// H2C represents the hash-to-curve string identifier.
 	H2C = "ristretto255_XMD:SHA-512_R255MAP_RO_"
 )
@@ -52,6 +56,26 @@ func (g Group) Base() internal.Element {
 	return &Element{*ristretto255.NewElement().Base()}
 }
 
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar.
+func (g Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	sc := assert(scalar)
+	return &Element{*ristretto255.NewElement().ScalarBaseMult(&sc.scalar)}
+}
+
+// VarTimeMultiScalarMult returns a new element set to the sum of the products of the scalars and the elements, in
+// variable time.
+func (g Group) VarTimeMultiScalarMult(scalars []internal.Scalar, elements []internal.Element) internal.Element {
+	s := make([]*ristretto255.Scalar, len(scalars))
+	e := make([]*ristretto255.Element, len(elements))
+
+	for i, scalar := range scalars {
+		s[i] = &assert(scalar).scalar
+		e[i] = &checkElement(elements[i]).element
+	}
+
+	return &Element{*ristretto255.NewElement().VarTimeMultiScalarMult(s, e)}
+}
+
 // HashFunc returns the RFC9380 associated hash function of the group.
 func (g Group) HashFunc() crypto.Hash {
 	return crypto.SHA512
@@ -60,18 +84,51 @@ func (g Group) HashFunc() crypto.Hash {
 // HashToScalar returns a safe mapping of the arbitrary input to a Scalar.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
-	uniform := hash2curve.ExpandXMD(crypto.SHA512, input, dst, inputLength)
-	return &Scalar{*ristretto255.NewScalar().FromUniformBytes(uniform)}
+	return g.ScalarFromUniform(hash2curve.ExpandXMD(crypto.SHA512, input, dst, inputLength))
+}
+
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	uniform := hash2curve.ExpandXMD(crypto.SHA512, input, dst, count*inputLength)
+	out := make([]internal.Scalar, count)
+
+	for i := range out {
+		out[i] = &Scalar{*ristretto255.NewScalar().FromUniformBytes(uniform[i*inputLength : (i+1)*inputLength])}
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite. Ristretto255
+// doesn't use hash_to_field to map to the group, so this uses those of edwards25519_XMD:SHA-512_ELL2_RO_.
+func (g Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	prime := new(big.Int).SetBytes(fieldPrimeBytes)
+	return hash2curve.HashToFieldXMD(crypto.SHA512, input, dst, count, 1, fieldSecLength, prime)
 }
 
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) internal.Element {
-	uniform := hash2curve.ExpandXMD(crypto.SHA512, input, dst, inputLength)
+	return g.ElementFromUniform(hash2curve.ExpandXMD(crypto.SHA512, input, dst, inputLength))
+}
+
+// UniformLengths returns the lengths of the expansions that HashToGroup and HashToScalar map.
+func (g Group) UniformLengths() (element, scalar uint) {
+	return inputLength, inputLength
+}
 
+// ElementFromUniform returns the element HashToGroup maps the uniform bytes of its expansion to.
+func (g Group) ElementFromUniform(uniform []byte) internal.Element {
 	return &Element{*ristretto255.NewElement().FromUniformBytes(uniform)}
 }
 
+// ScalarFromUniform returns the scalar HashToScalar maps the uniform bytes of its expansion to.
+func (g Group) ScalarFromUniform(uniform []byte) internal.Scalar {
+	return &Scalar{*ristretto255.NewScalar().FromUniformBytes(uniform)}
+}
+
 // EncodeToGroup returns a non-uniform mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) EncodeToGroup(input, dst []byte) internal.Element {
@@ -97,3 +154,17 @@ func (g Group) ElementLength() int {
 func (g Group) Order() []byte {
 	return slices.Clone(orderBytes)
 }
+
+// FieldPrime returns the big-endian encoding of the order of the base field of curve25519, p = 2^255 - 19, over which
+// Ristretto255 elements are encoded.
+func (g Group) FieldPrime() []byte {
+	return slices.Clone(fieldPrimeBytes)
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar. Ristretto255 is a prime-order group, so it's 1.
+func (g Group) Cofactor() []byte {
+	out := make([]byte, canonicalEncodingLength)
+	out[0] = 1
+
+	return out
+}
@@ -13,6 +13,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/gtank/ristretto255"
 
@@ -22,6 +23,7 @@ import (
 const canonicalEncodingLength = 32
 
 var (
+	order      big.Int
 	scZero     = &Scalar{*ristretto255.NewScalar()}
 	scOne      Scalar
 	scMinusOne = []byte{
@@ -37,9 +39,16 @@ var (
 		237, 211, 245, 92, 26, 99, 18, 88, 214, 156, 247, 162, 222, 249, 222, 20,
 		0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 16,
 	}
+	// fieldPrimeBytes is the big-endian encoding of the order of curve25519's base field, p = 2^255 - 19.
+	fieldPrimeBytes = []byte{
+		127, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
+		255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 237,
+	}
 )
 
 func init() {
+	order.SetBytes(internal.Reverse(orderBytes))
+
 	scOne = Scalar{*ristretto255.NewScalar()}
 	if err := scOne.Decode([]byte{
 		1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
@@ -143,6 +152,26 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+func (s *Scalar) MultiplyAdd(a, b internal.Scalar) internal.Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	sa := assert(a)
+	sb := assert(b)
+
+	// Use an intermediate value in case b aliases the receiver.
+	product := ristretto255.NewScalar().Multiply(&s.scalar, &sa.scalar)
+	s.scalar.Add(product, &sb.scalar)
+
+	return s
+}
+
 func getMSBit(in byte) int {
 	for i := 7; i >= 0; i-- {
 		mask := byte(1 << uint(i))
@@ -171,12 +200,27 @@ func (s *Scalar) square() {
 }
 
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
 func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
+	if scalar == nil {
+		return s.One()
+	}
+
+	sc := assert(scalar)
+
+	if sc.IsZero() {
+		return s.One()
+	}
+
+	if s.IsZero() {
+		return s
+	}
+
 	s1 := s.copy()
 	s2 := s.copy()
 	s2.square()
 
-	bytes := assert(scalar).Encode()
+	bytes := sc.Encode()
 	msbyte := getMSByte(bytes)
 	msbit := getMSBit(bytes[msbyte])
 
@@ -218,7 +262,7 @@ func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
-// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. The inverse of 0 is 0.
 func (s *Scalar) Invert() internal.Scalar {
 	s.scalar.Invert(&s.scalar)
 	return s
@@ -260,6 +304,17 @@ func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	return 1
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := assert(scalar)
+	return internal.CompareLittleEndian(s.Encode(), sc.Encode())
+}
+
+// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return internal.BitLittleEndian(s.Encode(), i)
+}
+
 // IsZero returns whether the scalar is 0.
 func (s *Scalar) IsZero() bool {
 	return s.scalar.Equal(&scZero.scalar) == 1
@@ -338,6 +393,17 @@ func (s *Scalar) decodeScalar(scalar []byte) error {
 	return nil
 }
 
+// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetBytesReduced(in []byte) internal.Scalar {
+	reduced := internal.Reverse(internal.ReduceBigEndian(in, &order, canonicalEncodingLength))
+	if err := s.decodeScalar(reduced); err != nil {
+		// This cannot happen, since the value is reduced.
+		panic(fmt.Sprintf("unexpected decoding of reduced scalar: %s", err))
+	}
+
+	return s
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
 	return s.decodeScalar(in)
@@ -36,10 +36,15 @@ type Scalar interface {
 	// Multiply multiplies the receiver with the input, and returns the receiver.
 	Multiply(Scalar) Scalar
 
+	// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+	MultiplyAdd(a, b Scalar) Scalar
+
 	// Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+	// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
 	Pow(scalar Scalar) Scalar
 
-	// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+	// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. The inverse of 0 must be
+	// 0 in all implementations.
 	Invert() Scalar
 
 	// Equal returns 1 if the scalars are equal, and 0 otherwise.
@@ -48,6 +53,12 @@ type Scalar interface {
 	// LessOrEqual returns 1 if s <= scalar, and 0 otherwise.
 	LessOrEqual(scalar Scalar) int
 
+	// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+	Cmp(scalar Scalar) int
+
+	// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+	Bit(i int) int
+
 	// IsZero returns whether the scalar is 0.
 	IsZero() bool
 
@@ -67,7 +78,11 @@ type Scalar interface {
 	// Encode returns the compressed byte encoding of the scalar.
 	Encode() []byte
 
-	// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+	// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+	SetBytesReduced(in []byte) Scalar
+
+	// Decode sets the receiver to a decoding of the input data, and returns an error on failure, leaving the receiver
+	// unchanged: implementations must fully validate the input before modifying the receiver.
 	Decode(in []byte) error
 
 	// Hex returns the fixed-sized hexadecimal encoding of s.
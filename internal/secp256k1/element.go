@@ -9,14 +9,24 @@
 package secp256k1
 
 import (
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/0xBridge/secp256k1"
 
 	"github.com/0xBridge/ecc/internal"
 )
 
+var (
+	// fieldPrime is the order of the base field, p = 2^256 - 2^32 - 977.
+	fieldPrime, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+
+	// sqrtExponent = (p + 1) / 4.
+	sqrtExponent = new(big.Int).Rsh(new(big.Int).Add(fieldPrime, big.NewInt(1)), 2)
+)
+
 // Element implements the Element interface for the Secp256k1 group element.
 type Element struct {
 	element *secp256k1.Element
@@ -73,13 +83,27 @@ func (e *Element) Double() internal.Element {
 
 // Negate sets the receiver to its negation, and returns it.
 func (e *Element) Negate() internal.Element {
-	e.element.Negate()
+	if e.element.IsIdentity() {
+		return e
+	}
+
+	// The underlying negation doesn't reduce the y coordinate modulo the field order, which flips the parity bit of the
+	// encoding of affine elements. Computing -P = P - 2P instead goes through the reducing addition formula.
+	e.element.Subtract(e.element.Copy().Double())
+
 	return e
 }
 
 // Subtract subtracts the input from the receiver, and returns the receiver.
 func (e *Element) Subtract(element internal.Element) internal.Element {
 	q := assertElement(element)
+
+	// The underlying subtraction returns the unreduced negation of the input if the receiver is the identity.
+	if e.element.IsIdentity() {
+		e.element.Set(q.element)
+		return e.Negate()
+	}
+
 	e.element.Subtract(q.element)
 
 	return e
@@ -99,11 +123,44 @@ func (e *Element) Equal(element internal.Element) int {
 	return e.element.Equal(q.element)
 }
 
+// ClearCofactor sets the receiver to its product with the group's cofactor, and returns it. The cofactor is 1, so
+// this is a no-op.
+func (e *Element) ClearCofactor() internal.Element {
+	return e
+}
+
+// IsValid returns whether the element is a non-identity element of the prime-order group. Since the group has
+// cofactor 1, any point other than the identity is valid.
+func (e *Element) IsValid() bool {
+	return !e.IsIdentity()
+}
+
 // IsIdentity returns whether the Element is the point at infinity of the Group's underlying curve.
 func (e *Element) IsIdentity() bool {
 	return e.element.IsIdentity()
 }
 
+// CMov sets the receiver to element if choice is 1, leaves it unchanged if choice is 0, and returns it. The library
+// doesn't expose its coordinates, so the selection is done on the encodings, and while the selection itself is
+// constant time, the encoding and decoding of the big.Int based implementation are not.
+func (e *Element) CMov(element internal.Element, choice int) internal.Element {
+	ec := assertElement(element)
+	encoded := e.element.Encode()
+	subtle.ConstantTimeCopy(choice, encoded, ec.element.Encode())
+
+	// The identity is encoded as zeros, which the library doesn't decode.
+	if subtle.ConstantTimeCompare(encoded, make([]byte, len(encoded))) == 1 {
+		e.element.Identity()
+		return e
+	}
+
+	if err := e.element.Decode(encoded); err != nil {
+		panic(err)
+	}
+
+	return e
+}
+
 // Set sets the receiver to the value of the argument, and returns the receiver.
 func (e *Element) Set(element internal.Element) internal.Element {
 	if element == nil {
@@ -126,6 +183,28 @@ func (e *Element) Encode() []byte {
 	return e.element.Encode()
 }
 
+// YCoordinate returns the big-endian encoded affine y coordinate of the element, or zeros for the identity.
+func (e *Element) YCoordinate() []byte {
+	y := make([]byte, scalarLength)
+	if e.IsIdentity() {
+		return y
+	}
+
+	encoded := e.Encode()
+	x := new(big.Int).SetBytes(encoded[1:])
+
+	// y^2 = x^3 + 7, and y = (y^2)^((p+1)/4) since p = 3 mod 4.
+	y2 := new(big.Int).Mul(x, x)
+	y2.Mul(y2, x).Add(y2, big.NewInt(7)).Mod(y2, fieldPrime)
+	r := new(big.Int).Exp(y2, sqrtExponent, fieldPrime)
+
+	if r.Bit(0) != uint(encoded[0]&1) {
+		r.Sub(fieldPrime, r)
+	}
+
+	return r.FillBytes(y)
+}
+
 // XCoordinate returns the encoded x coordinate of the element, which is the same as Encode().
 func (e *Element) XCoordinate() []byte {
 	return e.element.XCoordinate()
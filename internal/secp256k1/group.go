@@ -11,7 +11,9 @@ package secp256k1
 
 import (
 	"crypto"
+	"math/big"
 
+	"github.com/0xBridge/hash2curve"
 	"github.com/0xBridge/secp256k1"
 
 	"github.com/0xBridge/ecc/internal"
@@ -28,6 +30,10 @@ const (
 	E2CSECP256K1 = "secp256k1_XMD:SHA-256_SSWU_NU_"
 
 	scalarLength = 32
+
+	// secLength is the expansion length L of hash_to_field, to the base and scalar fields, for a security level of
+	// k = 128 bits.
+	secLength = 48
 )
 
 // Group represents the SECp256k1 group. It exposes a prime-order group API with hash-to-curve operations.
@@ -53,6 +59,13 @@ func (g Group) Base() internal.Element {
 	return newElement().Base()
 }
 
+// ScalarBaseMult returns a new element set to the product of the base point and the scalar, using a table of
+// precomputed multiples of the base point.
+func (g Group) ScalarBaseMult(scalar internal.Scalar) internal.Element {
+	sc := assert(scalar)
+	return &Element{element: scalarBaseMult(sc.scalar)}
+}
+
 // HashFunc returns the RFC9380 associated hash function of the group.
 func (g Group) HashFunc() crypto.Hash {
 	return crypto.SHA256
@@ -64,6 +77,25 @@ func (g Group) HashToScalar(input, dst []byte) internal.Scalar {
 	return &Scalar{scalar: secp256k1.HashToScalar(input, dst)}
 }
 
+// HashToScalars returns count independent safe mappings of the arbitrary input to Scalars, expanding the input once as
+// the RFC9380 hash_to_field function does, with the parameters of HashToScalar.
+func (g Group) HashToScalars(input, dst []byte, count uint) []internal.Scalar {
+	u := hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, count, 1, secLength, order)
+	out := make([]internal.Scalar, len(u))
+
+	for i, ui := range u {
+		out[i] = newScalar().SetBytesReduced(ui.Bytes())
+	}
+
+	return out
+}
+
+// HashToField returns the count elements of the base field produced by the RFC9380 hash_to_field function, with the
+// expansion parameters of the group's hash-to-curve suite.
+func (g Group) HashToField(input, dst []byte, count uint) []*big.Int {
+	return hash2curve.HashToFieldXMD(crypto.SHA256, input, dst, count, 1, secLength, fieldPrime)
+}
+
 // HashToGroup returns a safe mapping of the arbitrary input to an Element in the Group.
 // The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
 func (g Group) HashToGroup(input, dst []byte) internal.Element {
@@ -95,3 +127,16 @@ func (g Group) ElementLength() int {
 func (g Group) Order() []byte {
 	return secp256k1.Order()
 }
+
+// FieldPrime returns the big-endian encoding of the order of the base field, p = 2^256 - 2^32 - 977.
+func (g Group) FieldPrime() []byte {
+	return fieldPrime.FillBytes(make([]byte, secp256k1.ScalarLength()))
+}
+
+// Cofactor returns the cofactor of the group, encoded as a scalar. Secp256k1 has prime order, so it's 1.
+func (g Group) Cofactor() []byte {
+	out := make([]byte, secp256k1.ScalarLength())
+	out[len(out)-1] = 1
+
+	return out
+}
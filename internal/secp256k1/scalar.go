@@ -11,10 +11,17 @@ package secp256k1
 import (
 	"encoding/binary"
 	"fmt"
+	"math/big"
 
 	"github.com/0xBridge/secp256k1"
 
 	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/field"
+)
+
+var (
+	order       = new(big.Int).SetBytes(secp256k1.Order())
+	scalarField = field.NewField(order)
 )
 
 // Scalar implements the Scalar interface for Edwards25519 group scalars.
@@ -101,25 +108,60 @@ func (s *Scalar) Multiply(scalar internal.Scalar) internal.Scalar {
 	return s
 }
 
+// MultiplyAdd sets the receiver to s * a + b, and returns the receiver.
+func (s *Scalar) MultiplyAdd(a, b internal.Scalar) internal.Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	sa := assert(a)
+	sb := assert(b).scalar.Copy() // in case b aliases the receiver
+	s.scalar.Multiply(sa.scalar).Add(sb)
+
+	return s
+}
+
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
 func (s *Scalar) Pow(scalar internal.Scalar) internal.Scalar {
-	if scalar == nil || scalar.IsZero() {
+	if scalar == nil {
+		return s.One()
+	}
+
+	sc := assert(scalar)
+
+	if sc.IsZero() {
 		return s.One()
 	}
 
-	if scalar.Equal(newScalar().One()) == 1 {
+	if s.IsZero() {
 		return s
 	}
 
-	sc := assert(scalar)
+	if sc.Equal(newScalar().One()) == 1 {
+		return s
+	}
 	s.scalar.Pow(sc.scalar)
 
 	return s
 }
 
-// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it.
+// Invert sets the receiver to its modular inverse ( 1 / s ), and returns it. It runs in constant time, exponentiating
+// by order - 2 with a fixed addition chain, and the inverse of 0 is 0.
 func (s *Scalar) Invert() internal.Scalar {
-	s.scalar.Invert()
+	var x big.Int
+	x.SetBytes(s.scalar.Encode())
+	scalarField.InvConstantTime(&x, &x)
+
+	if err := s.scalar.Decode(x.FillBytes(make([]byte, scalarLength))); err != nil {
+		// This cannot happen, since the value is reduced.
+		panic(fmt.Sprintf("unexpected decoding of reduced scalar: %s", err))
+	}
+
 	return s
 }
 
@@ -140,6 +182,17 @@ func (s *Scalar) LessOrEqual(scalar internal.Scalar) int {
 	return s.scalar.LessOrEqual(sc.scalar)
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar, comparing their integer values.
+func (s *Scalar) Cmp(scalar internal.Scalar) int {
+	sc := assert(scalar)
+	return internal.CompareBigEndian(s.Encode(), sc.Encode())
+}
+
+// Bit returns the i-th least significant bit of the integer value of s, or 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return internal.BitBigEndian(s.Encode(), i)
+}
+
 // IsZero returns whether the scalar is 0.
 func (s *Scalar) IsZero() bool {
 	return s.scalar.IsZero()
@@ -190,8 +243,23 @@ func (s *Scalar) Encode() []byte {
 	return s.scalar.Encode()
 }
 
+// SetBytesReduced sets s to the big-endian integer in, of any length, reduced modulo the group order, and returns s.
+func (s *Scalar) SetBytesReduced(in []byte) internal.Scalar {
+	reduced := internal.ReduceBigEndian(in, order, scalarLength)
+	if err := s.scalar.Decode(reduced); err != nil {
+		// This cannot happen, since the value is reduced.
+		panic(fmt.Sprintf("unexpected decoding of reduced scalar: %s", err))
+	}
+
+	return s
+}
+
 // Decode sets the receiver to a decoding of the input data, and returns an error on failure.
 func (s *Scalar) Decode(in []byte) error {
+	if len(in) != scalarLength {
+		return internal.ErrParamScalarLength
+	}
+
 	if err := s.scalar.Decode(in); err != nil {
 		if err.Error() == "scalar too big" {
 			return internal.ErrParamScalarInvalidEncoding
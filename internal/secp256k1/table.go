@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C)2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package secp256k1
+
+import (
+	"crypto/subtle"
+	"math/big"
+	"sync"
+
+	"github.com/0xBridge/secp256k1"
+)
+
+const (
+	// baseTableWindow is the width, in bits, of the scalar digits indexing the base point table.
+	baseTableWindow = 4
+
+	// baseTableRows is the number of digits of a scalar, and thus of rows in the base point table.
+	baseTableRows = 8 * scalarLength / baseTableWindow
+
+	// elementLength is the length of the compressed encoding of a point.
+	elementLength = 33
+)
+
+var (
+	baseTableOnce sync.Once
+
+	// baseTable[i][d] is the compressed encoding of (d + 1) * 2^(baseTableWindow*i) * G. The offset by one keeps the
+	// identity, which has no compressed encoding, out of the table.
+	baseTable [baseTableRows][1 << baseTableWindow][elementLength]byte
+
+	// baseTableOffset = -sum(2^(baseTableWindow*i) * G), which cancels the offsets of the table entries.
+	baseTableOffset *secp256k1.Element
+)
+
+func initBaseTable() {
+	base := secp256k1.Base()
+
+	for i := range baseTable {
+		multiple := base.Copy()
+
+		for d := range baseTable[i] {
+			copy(baseTable[i][d][:], multiple.Encode())
+			multiple.Add(base)
+		}
+
+		for range baseTableWindow {
+			base.Double()
+		}
+	}
+
+	// sum(2^(baseTableWindow*i)) = (2^(8*scalarLength) - 1) / (2^baseTableWindow - 1).
+	offset := new(big.Int).Lsh(big.NewInt(1), 8*scalarLength)
+	offset.Sub(offset, big.NewInt(1))
+	offset.Div(offset, big.NewInt(1<<baseTableWindow-1))
+	offset.Sub(order, offset.Mod(offset, order))
+
+	s := secp256k1.NewScalar()
+	if err := s.Decode(offset.FillBytes(make([]byte, scalarLength))); err != nil {
+		panic(err)
+	}
+
+	baseTableOffset = secp256k1.Base()
+	baseTableOffset.Multiply(s)
+}
+
+// scalarBaseMult returns s * G as the sum of one precomputed multiple of the base point per digit of s, which avoids
+// all doublings. Every entry of a row is read to select the one for a digit, so that the memory access pattern doesn't
+// depend on s. The library doesn't expose its coordinates, so the entries are selected as encodings and then decoded,
+// which, as all its arithmetic, is not constant time.
+func scalarBaseMult(s *secp256k1.Scalar) *secp256k1.Element {
+	baseTableOnce.Do(initBaseTable)
+
+	encoded := s.Encode()
+	result := baseTableOffset.Copy()
+	entry := secp256k1.NewElement()
+
+	var selected [elementLength]byte
+
+	for i := range baseTable {
+		b := encoded[len(encoded)-1-i/2]
+		digit := int(b>>(baseTableWindow*(i%2))) & (1<<baseTableWindow - 1)
+
+		for d := range baseTable[i] {
+			subtle.ConstantTimeCopy(subtle.ConstantTimeEq(int32(d), int32(digit)), selected[:], baseTable[i][d][:])
+		}
+
+		if err := entry.Decode(selected[:]); err != nil {
+			panic(err)
+		}
+
+		result.Add(entry)
+	}
+
+	return result
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+var errKeyLength = errors.New("invalid key length")
+
+// DeriveSharedKey computes the Diffie-Hellman shared element secret * peer, and derives length bytes of key material
+// from it using HKDF (RFC 5869) instantiated with the group's hash function (see HashFunc), salt, and info.
+//
+// The input keying material fed to HKDF is the canonical encoding of the shared element, as returned by
+// Element.Encode(), i.e. the compressed SEC1 encoding for the NIST groups and secp256k1, the 32-byte encoding for
+// Ristretto255, Edwards25519, Pallas, and Vesta, the 56-byte encoding for Decaf448, the 57-byte encoding for
+// Edwards448, and the 48-byte compressed ZCash encoding for BLS12-381 G1.
+//
+// An error is returned if secret or peer is nil, if the shared element is the identity, or if length is not between 1
+// and 255 times the hash function's output size.
+func (g Group) DeriveSharedKey(secret *Scalar, peer *Element, salt, info []byte, length int) ([]byte, error) {
+	if secret == nil || secret.IsZero() {
+		return nil, fmt.Errorf("DeriveSharedKey: %w", internal.ErrParamNilScalar)
+	}
+
+	if peer == nil {
+		return nil, fmt.Errorf("DeriveSharedKey: %w", internal.ErrParamNilPoint)
+	}
+
+	if length <= 0 || length > 255*g.HashFunc().Size() {
+		return nil, fmt.Errorf("DeriveSharedKey: %w", errKeyLength)
+	}
+
+	shared := peer.Copy().Multiply(secret)
+	if shared.IsIdentity() {
+		return nil, fmt.Errorf("DeriveSharedKey: %w", internal.ErrIdentity)
+	}
+
+	key := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(g.HashFunc().New, shared.Encode(), salt, info), key); err != nil {
+		return nil, fmt.Errorf("DeriveSharedKey: %w", err)
+	}
+
+	return key, nil
+}
+
+// DeriveScalar derives a scalar from the input keying material ikm, e.g. a master secret, using HKDF (RFC 5869)
+// instantiated with the group's hash function (see HashFunc), salt, and info. HKDF outputs ScalarLength + 16 bytes,
+// which are reduced modulo the order as a big-endian integer: these are at least 128 bits more than the length of the
+// order, which bounds the statistical distance of the scalar to the uniform distribution by 2^-128. The same inputs
+// always yield the same scalar, which is zero only with negligible probability.
+func (g Group) DeriveScalar(ikm, salt, info []byte) *Scalar {
+	uniform := make([]byte, g.ScalarLength()+uniformSecurityLength)
+
+	// HKDF can't fail for this length, which is far below 255 times the output size of the hash function.
+	_, _ = io.ReadFull(hkdf.New(g.HashFunc().New, ikm, salt, info), uniform)
+
+	return g.NewScalar().SetBigInt(new(big.Int).SetBytes(uniform))
+}
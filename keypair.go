@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	keyPairDSTApp     = "KeyPair"
+	keyPairDSTVersion = 1
+	maxKeyPairCounter = 255
+)
+
+var (
+	errSeedLength      = errors.New("seed is shorter than the scalar length")
+	errKeyPairDerive   = errors.New("key pair derivation failed")
+	errKeyPairMismatch = errors.New("public key does not match the secret key")
+)
+
+// KeyPair holds a non-zero secret scalar and its public element, the product of the group's base point and the secret.
+type KeyPair struct {
+	secret *Scalar
+	public *Element
+}
+
+// newKeyPair returns the key pair of the non-zero secret.
+func newKeyPair(secret *Scalar) *KeyPair {
+	return &KeyPair{secret: secret, public: secret.Group().ScalarBaseMult(secret)}
+}
+
+// NewKeyPair returns a new key pair with a random secret scalar.
+func (g Group) NewKeyPair() *KeyPair {
+	return newKeyPair(g.NewScalar().Random())
+}
+
+// KeyPairFromSeed deterministically derives a key pair from the seed, which must be secret and uniformly random, of at
+// least ScalarLength bytes. The secret is HashToScalar of the seed suffixed with a counter byte, incremented in the
+// negligible event of a zero scalar, with the DST MakeDST("KeyPair", 1).
+func (g Group) KeyPairFromSeed(seed []byte) (*KeyPair, error) {
+	if len(seed) < g.ScalarLength() {
+		return nil, fmt.Errorf("KeyPairFromSeed: %w", errSeedLength)
+	}
+
+	dst := g.MakeDST(keyPairDSTApp, keyPairDSTVersion)
+	input := append(append(make([]byte, 0, len(seed)+1), seed...), 0)
+
+	for counter := 0; counter <= maxKeyPairCounter; counter++ {
+		input[len(seed)] = byte(counter)
+
+		if sk := g.HashToScalar(input, dst); !sk.IsZero() {
+			return newKeyPair(sk), nil
+		}
+	}
+
+	return nil, fmt.Errorf("KeyPairFromSeed: %w", errKeyPairDerive)
+}
+
+// Group returns the group of the key pair.
+func (k *KeyPair) Group() Group {
+	return k.secret.Group()
+}
+
+// Secret returns a copy of the secret scalar.
+func (k *KeyPair) Secret() *Scalar {
+	return k.secret.Copy()
+}
+
+// Public returns a copy of the public element.
+func (k *KeyPair) Public() *Element {
+	return k.public.Copy()
+}
+
+// setSecret sets the key pair to the one of the secret, which must not be zero.
+func (k *KeyPair) setSecret(secret *Scalar) error {
+	if secret.IsZero() {
+		return internal.ErrParamNilScalar
+	}
+
+	k.secret = secret
+	k.public = secret.Group().ScalarBaseMult(secret)
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, and returns the group identifier followed by the
+// encoding of the secret scalar, from which the public element is recomputed when decoding.
+func (k *KeyPair) MarshalBinary() ([]byte, error) {
+	return k.secret.GobEncode()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface, and sets the key pair to the decoding of the
+// output of MarshalBinary.
+func (k *KeyPair) UnmarshalBinary(data []byte) error {
+	secret := new(Scalar)
+	if err := secret.GobDecode(data); err != nil {
+		return fmt.Errorf("key pair UnmarshalBinary: %w", err)
+	}
+
+	if err := k.setSecret(secret); err != nil {
+		return fmt.Errorf("key pair UnmarshalBinary: %w", err)
+	}
+
+	return nil
+}
+
+// keyPairJSON is the JSON representation of a KeyPair.
+type keyPairJSON struct {
+	Group  Group  `json:"group"`
+	Secret string `json:"secret"`
+	Public string `json:"public"`
+}
+
+// MarshalJSON marshals the key pair into a JSON object of its group identifier and the hex encodings of its secret
+// scalar and public element.
+func (k *KeyPair) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keyPairJSON{Group: k.Group(), Secret: k.secret.Hex(), Public: k.public.Hex()})
+}
+
+// UnmarshalJSON unmarshals the output of MarshalJSON into the key pair. An error is returned if the public element
+// doesn't match the secret scalar.
+func (k *KeyPair) UnmarshalJSON(data []byte) error {
+	var j keyPairJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return fmt.Errorf("key pair UnmarshalJSON: %w", err)
+	}
+
+	if !j.Group.Available() {
+		return fmt.Errorf("key pair UnmarshalJSON: %w", internal.ErrInvalidGroup)
+	}
+
+	secret := j.Group.NewScalar()
+	if err := secret.DecodeHex(j.Secret); err != nil {
+		return fmt.Errorf("key pair UnmarshalJSON: %w", err)
+	}
+
+	public := j.Group.NewElement()
+	if err := public.DecodeHex(j.Public); err != nil {
+		return fmt.Errorf("key pair UnmarshalJSON: %w", err)
+	}
+
+	var kp KeyPair
+	if err := kp.setSecret(secret); err != nil {
+		return fmt.Errorf("key pair UnmarshalJSON: %w", err)
+	}
+
+	if !kp.public.Equal(public) {
+		return fmt.Errorf("key pair UnmarshalJSON: %w", errKeyPairMismatch)
+	}
+
+	*k = kp
+
+	return nil
+}
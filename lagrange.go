@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+var (
+	// ErrNoIdentifiers indicates that a Lagrange coefficient was requested over an empty set of identifiers.
+	ErrNoIdentifiers = errors.New("empty identifier set")
+
+	// ErrZeroIdentifier indicates that a set of identifiers holds a zero scalar, which can't identify a participant as
+	// the polynomial is evaluated at zero.
+	ErrZeroIdentifier = errors.New("identifier must not be zero")
+
+	// ErrDuplicateIdentifier indicates that a set of identifiers holds the same identifier more than once.
+	ErrDuplicateIdentifier = errors.New("duplicate identifier")
+
+	// ErrUnknownIdentifier indicates that the identifier of a Lagrange coefficient is not in the set of identifiers.
+	ErrUnknownIdentifier = errors.New("identifier is not in the identifier set")
+)
+
+// checkIdentifiers returns an error if the identifiers are empty, or if one of them is nil, of another group, zero, or
+// duplicated.
+func (g Group) checkIdentifiers(identifiers []*Scalar) error {
+	if len(identifiers) == 0 {
+		return ErrNoIdentifiers
+	}
+
+	seen := make(map[string]struct{}, len(identifiers))
+
+	for _, id := range identifiers {
+		switch {
+		case id == nil:
+			return internal.ErrParamNilScalar
+		case id.Group() != g:
+			return ErrWrongGroup
+		case id.IsZero():
+			return ErrZeroIdentifier
+		}
+
+		encoded := string(id.Encode())
+		if _, ok := seen[encoded]; ok {
+			return ErrDuplicateIdentifier
+		}
+
+		seen[encoded] = struct{}{}
+	}
+
+	return nil
+}
+
+// lagrangeTerms returns the numerator and the denominator of the Lagrange coefficient at zero of identifiers[i], i.e.
+// the products of x_j and of x_j - x_i for j != i.
+func (g Group) lagrangeTerms(identifiers []*Scalar, i int) (numerator, denominator *Scalar) {
+	numerator = g.NewScalar().One()
+	denominator = g.NewScalar().One()
+
+	for j, xj := range identifiers {
+		if i == j {
+			continue
+		}
+
+		numerator.Multiply(xj)
+		denominator.Multiply(xj.Copy().Subtract(identifiers[i]))
+	}
+
+	return numerator, denominator
+}
+
+// LagrangeCoefficient returns the Lagrange coefficient at zero of the participant identified by index among the
+// signers identified by identifiers, i.e. the product of x_j / (x_j - index) for the identifiers x_j other than index,
+// so that the sum of the coefficients times the shares f(x_i) of the signers is f(0). Such shares are e.g. those of
+// FROST and other threshold schemes. An error is returned if the identifiers are empty, or if one of them is nil, of
+// another group, zero, or duplicated, or if index is not among them.
+func (g Group) LagrangeCoefficient(identifiers []*Scalar, index *Scalar) (*Scalar, error) {
+	if err := g.checkIdentifiers(identifiers); err != nil {
+		return nil, fmt.Errorf("LagrangeCoefficient: %w", err)
+	}
+
+	if index == nil {
+		return nil, fmt.Errorf("LagrangeCoefficient: %w", internal.ErrParamNilScalar)
+	}
+
+	if index.Group() != g {
+		return nil, fmt.Errorf("LagrangeCoefficient: %w", ErrWrongGroup)
+	}
+
+	for i, id := range identifiers {
+		if id.Equal(index) {
+			numerator, denominator := g.lagrangeTerms(identifiers, i)
+			return numerator.Multiply(denominator.Invert()), nil
+		}
+	}
+
+	return nil, fmt.Errorf("LagrangeCoefficient: %w", ErrUnknownIdentifier)
+}
+
+// LagrangeCoefficients returns the Lagrange coefficients at zero of all the identifiers, in their order, i.e.
+// LagrangeCoefficient(identifiers, identifiers[i]) for each i, with a single inversion for all of them. An error is
+// returned like LagrangeCoefficient.
+func (g Group) LagrangeCoefficients(identifiers []*Scalar) ([]*Scalar, error) {
+	if err := g.checkIdentifiers(identifiers); err != nil {
+		return nil, fmt.Errorf("LagrangeCoefficients: %w", err)
+	}
+
+	numerators := make([]*Scalar, len(identifiers))
+	denominators := &ScalarVector{scalars: make([]*Scalar, len(identifiers)), group: g}
+
+	for i := range identifiers {
+		numerators[i], denominators.scalars[i] = g.lagrangeTerms(identifiers, i)
+	}
+
+	// The denominators are products of differences of distinct identifiers, and hence never zero.
+	if err := denominators.BatchInvert(); err != nil {
+		return nil, fmt.Errorf("LagrangeCoefficients: %w", err)
+	}
+
+	for i, n := range numerators {
+		n.Multiply(denominators.scalars[i])
+	}
+
+	return numerators, nil
+}
@@ -0,0 +1,341 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"math/bits"
+	"runtime"
+	"sync"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	// strausThreshold is the number of non-neutral terms from which MultiScalarMult switches from Straus' method to
+	// Pippenger's bucket method.
+	strausThreshold = 64
+
+	// strausWindow is the window width, in bits, of Straus' method.
+	strausWindow = 4
+
+	// pippengerMinWindow and pippengerMaxWindow bound the window width, in bits, of Pippenger's bucket method.
+	pippengerMinWindow = 4
+	pippengerMaxWindow = 16
+)
+
+// MultiScalarMult returns the sum of the pairwise products of the scalars and elements, i.e. sum(scalars[i] *
+// elements[i]). Pairs where the scalar or element is nil, the scalar is zero, or the element is the identity contribute
+// nothing and are skipped. The identity element is returned for empty input. It panics if the two slices have different
+// lengths, or if a scalar or element does not belong to the group, with an error matching ErrWrongGroup. All terms are
+// checked before any computation.
+//
+// The underlying library's variable-time multi-scalar multiplication is used if there's one, and otherwise Straus'
+// method for small inputs, and Pippenger's bucket method for large inputs. All run in variable time with respect to the
+// scalars, which must therefore be public (e.g. when verifying signatures).
+func (g Group) MultiScalarMult(scalars []*Scalar, elements []*Element) *Element {
+	s, e := g.msmTerms(scalars, elements)
+	return g.multiScalarMult(s, e)
+}
+
+// MultiScalarMultParallel returns the same result as MultiScalarMult, computed by splitting the terms into as many
+// parts as there are workers, whose partial sums are computed concurrently and then added. With workers <= 0,
+// runtime.NumCPU() workers are used. Parts are at least as large as the threshold of Pippenger's method, so that small
+// inputs use fewer workers, down to a single one. It panics like MultiScalarMult.
+func (g Group) MultiScalarMultParallel(scalars []*Scalar, elements []*Element, workers int) *Element {
+	s, e := g.msmTerms(scalars, elements)
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	workers = max(min(workers, len(s)/strausThreshold), 1)
+	if workers == 1 {
+		return g.multiScalarMult(s, e)
+	}
+
+	partials := make([]*Element, workers)
+	size := (len(s) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+
+	for w := range workers {
+		start, end := w*size, min((w+1)*size, len(s))
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			partials[w] = g.multiScalarMult(s[start:end], e[start:end])
+		}()
+	}
+
+	wg.Wait()
+
+	result := partials[0]
+	for _, p := range partials[1:] {
+		result.Add(p)
+	}
+
+	return result
+}
+
+// msmTerms returns the scalars and elements of the terms of a multi-scalar multiplication that are not neutral. It
+// panics if the two slices have different lengths, or if a scalar or element does not belong to the group, so that no
+// computation starts on mixed inputs.
+func (g Group) msmTerms(scalars []*Scalar, elements []*Element) ([]internal.Scalar, []internal.Element) {
+	if len(scalars) != len(elements) {
+		panic(internal.ErrParamLengthMismatch)
+	}
+
+	s := make([]internal.Scalar, 0, len(scalars))
+	e := make([]internal.Element, 0, len(elements))
+
+	for i, scalar := range scalars {
+		if scalar != nil && scalar.Group() != g {
+			panic(internal.ErrCastScalar)
+		}
+
+		if elements[i] != nil && elements[i].Group() != g {
+			panic(internal.ErrCastElement)
+		}
+
+		if isNeutralTerm(scalar, elements[i]) {
+			continue
+		}
+
+		s = append(s, scalar.Scalar)
+		e = append(e, elements[i].Element)
+	}
+
+	return s, e
+}
+
+// multiScalarMult returns the multi-scalar multiplication of the non-neutral terms.
+func (g Group) multiScalarMult(s []internal.Scalar, e []internal.Element) *Element {
+	if len(s) == 0 {
+		return g.NewElement()
+	}
+
+	if m, ok := g.get().(internal.VarTimeMultiScalarMultiplier); ok {
+		return newPoint(m.VarTimeMultiScalarMult(s, e))
+	}
+
+	encoded := make([][]byte, len(s))
+	for i, scalar := range s {
+		encoded[i] = g.littleEndianScalar(scalar)
+	}
+
+	if len(s) < strausThreshold {
+		return g.straus(encoded, e)
+	}
+
+	return g.pippenger(encoded, e)
+}
+
+// isNeutralTerm returns whether the product s * e is trivially the identity, and can thus be ignored in a sum.
+func isNeutralTerm(s *Scalar, e *Element) bool {
+	return s == nil || e == nil || s.IsZero() || e.IsIdentity()
+}
+
+// scalarBitLen returns the bit length of the group order, which bounds that of all scalars.
+func (g Group) scalarBitLen() int {
+	return g.OrderBigInt().BitLen()
+}
+
+// scalarDigit returns the width-bit digit starting at bit offset of the little-endian encoded scalar.
+func scalarDigit(scalar []byte, offset, width int) int {
+	digit := 0
+
+	for i := range width {
+		bit := offset + i
+		if bit >= 8*len(scalar) {
+			break
+		}
+
+		digit |= int(scalar[bit/8]>>(bit%8)&1) << i
+	}
+
+	return digit
+}
+
+// straus computes the multi-scalar multiplication of the little-endian encoded scalars and the elements, by sharing
+// the doublings across all terms and adding the precomputed multiples of each element indexed by the scalars' digits.
+func (g Group) straus(scalars [][]byte, elements []internal.Element) *Element {
+	result := g.NewElement()
+	if len(scalars) == 0 {
+		return result
+	}
+
+	// tables[i][d] = d * elements[i], for 0 < d < 2^strausWindow.
+	tables := make([][]*Element, len(elements))
+	for i, ie := range elements {
+		e := newPoint(ie)
+		table := make([]*Element, 1<<strausWindow)
+		table[1] = e.Copy()
+		table[2] = e.Copy().Double()
+
+		for d := 3; d < len(table); d++ {
+			table[d] = table[d-1].Copy().Add(e)
+		}
+
+		tables[i] = table
+	}
+
+	windows := (g.scalarBitLen() + strausWindow - 1) / strausWindow
+	for w := windows - 1; w >= 0; w-- {
+		if w != windows-1 {
+			for range strausWindow {
+				result.Double()
+			}
+		}
+
+		for i, s := range scalars {
+			if d := scalarDigit(s, w*strausWindow, strausWindow); d != 0 {
+				result.Add(tables[i][d])
+			}
+		}
+	}
+
+	return result
+}
+
+// pippengerWindow returns the window width of Pippenger's method for n terms. Each window costs n additions to fill
+// the buckets and 2^(width+1) to sum them, so the width balancing both is about log2(n) - log2(log2(n)).
+func pippengerWindow(n int) int {
+	l := bits.Len(uint(n))
+	return min(max(l-bits.Len(uint(l)), pippengerMinWindow), pippengerMaxWindow)
+}
+
+// pippenger computes the multi-scalar multiplication of the little-endian encoded scalars and the elements using the
+// bucket method: for each window, the elements are accumulated in the bucket of their scalar's digit, and the weighted
+// sum of the buckets is obtained with running sums.
+func (g Group) pippenger(scalars [][]byte, elements []internal.Element) *Element {
+	width := pippengerWindow(len(scalars))
+	result := g.NewElement()
+	buckets := make([]*Element, 1<<width)
+	windows := (g.scalarBitLen() + width - 1) / width
+
+	for w := windows - 1; w >= 0; w-- {
+		if w != windows-1 {
+			for range width {
+				result.Double()
+			}
+		}
+
+		for d := range buckets {
+			buckets[d] = g.NewElement()
+		}
+
+		for i, s := range scalars {
+			if d := scalarDigit(s, w*width, width); d != 0 {
+				buckets[d].Element.Add(elements[i])
+			}
+		}
+
+		// sum(d * buckets[d]) = sum over d of the running sums of buckets[d'] for d' >= d.
+		running, sum := g.NewElement(), g.NewElement()
+		for d := len(buckets) - 1; d > 0; d-- {
+			running.Add(buckets[d])
+			sum.Add(running)
+		}
+
+		result.Add(sum)
+	}
+
+	return result
+}
+
+// DoubleScalarBaseMult returns s1 * e1 + s2 * e2, sharing the doublings of both scalar multiplications (Shamir's trick),
+// e.g. to verify Schnorr signatures. As MultiScalarMult, it runs in variable time with respect to the scalars, and nil
+// or zero scalars and nil or identity elements contribute nothing to the sum. It panics if an input does not belong to
+// the group.
+//
+// The underlying library's variable-time multi-scalar multiplication is used if there's one, and otherwise the
+// interleaved width-w non-adjacent forms of the scalars, as in MultiplyVartime.
+func (g Group) DoubleScalarBaseMult(s1 *Scalar, e1 *Element, s2 *Scalar, e2 *Element) *Element {
+	s, e := g.msmTerms([]*Scalar{s1, s2}, []*Element{e1, e2})
+	if _, ok := g.get().(internal.VarTimeMultiScalarMultiplier); ok || len(s) == 0 {
+		return g.multiScalarMult(s, e)
+	}
+
+	encoded := make([][]byte, len(s))
+	for i, scalar := range s {
+		encoded[i] = g.littleEndianScalar(scalar)
+	}
+
+	return g.wnafMult(encoded, e)
+}
+
+// DoubleScalarBaseMultBase returns s1 * G + s2 * e2, where G is the group's base point, using the precomputed multiples
+// of the base point for the first product. As DoubleScalarBaseMult, it runs in variable time with respect to the
+// scalars, and panics if an input does not belong to the group.
+func (g Group) DoubleScalarBaseMultBase(s1, s2 *Scalar, e2 *Element) *Element {
+	if s1 != nil && s1.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	return g.ScalarBaseMult(s1).Add(g.MultiScalarMult([]*Scalar{s2}, []*Element{e2}))
+}
+
+// BaseMultAll returns the products of the group's base point with each of the scalars, i.e. a slice where the i-th
+// element is scalars[i] * Base(). The identity element is returned for nil or zero scalars. Contrary to
+// MultiScalarMult, the products are not summed.
+//
+// For BLS12-381 G1, Pallas, and Vesta, the products are computed together over the shared BaseTable: each row of the
+// table is walked once for all the scalars, and the results are brought to affine coordinates with a single field
+// inversion shared by all of them, so that encoding them takes no further inversion. The other groups multiply each
+// scalar with their backend's own base point table, which is faster than the shared one, and whose elements are only
+// normalized when encoded. It panics if a scalar does not belong to the group, before computing any product.
+func (g Group) BaseMultAll(scalars []*Scalar) []*Element {
+	for _, s := range scalars {
+		if s != nil && s.Group() != g {
+			panic(internal.ErrCastScalar)
+		}
+	}
+
+	if !g.hasBaseTable() {
+		return g.BaseTable().multiplyAll(scalars)
+	}
+
+	elements := make([]*Element, len(scalars))
+	for i, s := range scalars {
+		elements[i] = g.ScalarBaseMult(s)
+	}
+
+	return elements
+}
+
+// Sum returns the sum of the elements, and the identity element for empty input. Nil elements are ignored, as in
+// Element.Add. It panics if an element does not belong to the group, before adding any.
+func (g Group) Sum(elements ...*Element) *Element {
+	for _, e := range elements {
+		if e != nil && e.Group() != g {
+			panic(internal.ErrCastElement)
+		}
+	}
+
+	sum := g.NewElement()
+	for _, e := range elements {
+		sum.Add(e)
+	}
+
+	return sum
+}
+
+// SumElements returns the sum of the elements, as Group.Sum does for the group of the first non-nil element. It
+// returns nil if there is no such element, since the group can't be inferred.
+func SumElements(elements []*Element) *Element {
+	for _, e := range elements {
+		if e != nil {
+			return e.Group().Sum(elements...)
+		}
+	}
+
+	return nil
+}
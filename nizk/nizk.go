@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package nizk implements non-interactive zero-knowledge proofs of discrete logarithm knowledge over any ecc group:
+// Schnorr's proof of knowledge of x such that P = x * G, and Chaum-Pedersen's proof of discrete logarithm equality
+// log_G(A) = log_H(B). Both are made non-interactive with the Fiat-Shamir transform, hashing the whole statement and
+// the commitments to the challenge with HashToScalar.
+package nizk
+
+import (
+	"fmt"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	app     = "ECC-NIZK"
+	version = 1
+
+	// nonceEntropy is the byte length of the randomness mixed into the nonce derivation.
+	nonceEntropy = 32
+)
+
+// Proof is a proof of discrete logarithm knowledge, with the Fiat-Shamir challenge c and the response z.
+type Proof struct {
+	Challenge *ecc.Scalar
+	Response  *ecc.Scalar
+}
+
+// Encode returns the encoding of the challenge and the response.
+func (p Proof) Encode() []byte {
+	return append(p.Challenge.Encode(), p.Response.Encode()...)
+}
+
+// Decode sets the receiver to the decoding of the proof in the group, and returns an error on failure.
+func (p *Proof) Decode(g ecc.Group, data []byte) error {
+	length := g.ScalarLength()
+	if len(data) != 2*length {
+		return fmt.Errorf("proof Decode: %w", internal.ErrDecodingInvalidLength)
+	}
+
+	c := g.NewScalar()
+	if err := c.Decode(data[:length]); err != nil {
+		return fmt.Errorf("proof Decode: %w", err)
+	}
+
+	z := g.NewScalar()
+	if err := z.Decode(data[length:]); err != nil {
+		return fmt.Errorf("proof Decode: %w", err)
+	}
+
+	p.Challenge, p.Response = c, z
+
+	return nil
+}
+
+// ProveDLog returns a proof of knowledge of the secret key sk such that point = sk * base. It panics if an input is nil
+// or of another group than sk. The proof is only valid if the relation holds.
+func ProveDLog(sk *ecc.Scalar, base, point *ecc.Element) Proof {
+	return prove("-DLog", sk, []*ecc.Element{base, point})
+}
+
+// VerifyDLog returns whether the proof shows knowledge of the discrete logarithm of point in base. It runs in variable
+// time, and returns false if an input is nil, the identity, or of another group.
+func VerifyDLog(proof Proof, base, point *ecc.Element) bool {
+	return verify("-DLog", proof, []*ecc.Element{base, point})
+}
+
+// ProveDLEQ returns a proof of knowledge of the secret key sk such that a = sk * g and b = sk * h, i.e. that
+// log_g(a) = log_h(b). It panics if an input is nil or of another group than sk. The proof is only valid if the
+// relations hold.
+func ProveDLEQ(sk *ecc.Scalar, g, a, h, b *ecc.Element) Proof {
+	return prove("-DLEQ", sk, []*ecc.Element{g, a, h, b})
+}
+
+// VerifyDLEQ returns whether the proof shows that log_g(a) = log_h(b). It runs in variable time, and returns false if
+// an input is nil, the identity, or of another group.
+func VerifyDLEQ(proof Proof, g, a, h, b *ecc.Element) bool {
+	return verify("-DLEQ", proof, []*ecc.Element{g, a, h, b})
+}
+
+// prove returns the proof for the statement, a list of (base, point) pairs sharing the discrete logarithm sk.
+func prove(label string, sk *ecc.Scalar, statement []*ecc.Element) Proof {
+	if sk == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	g := sk.Group()
+
+	for _, e := range statement {
+		if e == nil {
+			panic(internal.ErrParamNilPoint)
+		}
+
+		if e.Group() != g {
+			panic(internal.ErrCastElement)
+		}
+	}
+
+	// The nonce is hedged: it stays secret with a weak random source, and differs across proofs of the statement.
+	input := internal.RandomBytes(nonceEntropy)
+	input = append(input, sk.Encode()...)
+	input = append(input, encodeStatement(statement)...)
+
+	k := g.HashToScalar(input, g.MakeDST(app+label+"-nonce", version))
+	commitments := make([]*ecc.Element, 0, len(statement)/2)
+
+	for i := 0; i < len(statement); i += 2 {
+		commitments = append(commitments, statement[i].Copy().Multiply(k))
+	}
+
+	c := challenge(g, label, statement, commitments)
+
+	return Proof{
+		Challenge: c,
+		Response:  c.Copy().MultiplyAdd(sk, k),
+	}
+}
+
+// verify returns whether the proof is valid for the statement.
+func verify(label string, proof Proof, statement []*ecc.Element) bool {
+	if proof.Challenge == nil || proof.Response == nil {
+		return false
+	}
+
+	g := proof.Challenge.Group()
+	if proof.Response.Group() != g {
+		return false
+	}
+
+	for _, e := range statement {
+		if e == nil || e.Group() != g || e.IsIdentity() {
+			return false
+		}
+	}
+
+	// The commitment of each pair is z * base - c * point.
+	minusC := g.NewScalar().Subtract(proof.Challenge)
+	commitments := make([]*ecc.Element, 0, len(statement)/2)
+
+	for i := 0; i < len(statement); i += 2 {
+		commitments = append(commitments, g.MultiScalarMult(
+			[]*ecc.Scalar{proof.Response, minusC},
+			[]*ecc.Element{statement[i], statement[i+1]},
+		))
+	}
+
+	return challenge(g, label, statement, commitments).Equal(proof.Challenge)
+}
+
+// challenge returns the Fiat-Shamir challenge binding the statement and the commitments.
+func challenge(g ecc.Group, label string, statement, commitments []*ecc.Element) *ecc.Scalar {
+	transcript := encodeStatement(statement)
+	transcript = append(transcript, encodeStatement(commitments)...)
+
+	return g.HashToScalar(transcript, g.MakeDST(app+label+"-challenge", version))
+}
+
+// encodeStatement returns the concatenated encodings of the elements, each prefixed with its byte length so that the
+// shorter encoding of the identity in some groups can't be confused with another.
+func encodeStatement(elements []*ecc.Element) []byte {
+	var out []byte
+
+	for _, e := range elements {
+		enc := e.Encode()
+		out = append(out, byte(len(enc)>>8), byte(len(enc)))
+		out = append(out, enc...)
+	}
+
+	return out
+}
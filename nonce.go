@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"math/big"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/rfc6979"
+)
+
+// DeterministicNonce returns the deterministic nonce in [1, order) of RFC 6979 for the secret key sk and the message
+// msg, which is hashed with the group's hash function (see HashFunc), as is HMAC_DRBG. The optional extra data is
+// mixed in as specified in RFC 6979 section 3.6, e.g. to hedge the nonce with fresh randomness. Without extra data,
+// the nonce is the first candidate of the ECDSA signature of msg with sk, so it must not be used in another scheme with
+// the same key and message. It panics if sk is nil, zero, or of another group.
+func (g Group) DeterministicNonce(sk *Scalar, msg, extra []byte) *Scalar {
+	if sk == nil || sk.IsZero() {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	if sk.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	order := g.OrderBigInt()
+	x := new(big.Int).SetBytes(internal.Reverse(g.littleEndianScalar(sk.Scalar)))
+
+	h := g.HashFunc().New()
+	_, _ = h.Write(msg)
+
+	nonces := rfc6979.New(g.HashFunc().New, order, rfc6979.Int2Octets(order, x), rfc6979.Bits2Octets(order, h.Sum(nil)),
+		extra)
+
+	return g.NewScalar().SetBytesReduced(nonces.Next().Bytes())
+}
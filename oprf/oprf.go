@@ -0,0 +1,397 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package oprf implements the Oblivious Pseudorandom Function (OPRF) and Verifiable OPRF (VOPRF) protocols of RFC 9497,
+// with the ristretto255-SHA512 and P256-SHA256 ciphersuites, i.e. over the ecc.Ristretto255Sha512 and ecc.P256Sha256
+// groups.
+//
+// The client blinds its input with Blind and sends the blinded element to the server, which evaluates it with its
+// secret key in BlindEvaluate. The client then unblinds the evaluated element in Finalize to obtain the PRF output,
+// without the server learning the input or the output. In ModeVOPRF, the server additionally proves that it evaluated
+// the blinded element with the secret key of its public key, and Finalize verifies that proof.
+package oprf
+
+import (
+	"crypto"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+// Mode identifies the protocol variant.
+type Mode byte
+
+const (
+	// ModeOPRF is the base protocol, in which the client can't verify which key the server evaluated its input with.
+	ModeOPRF Mode = 0x00
+
+	// ModeVOPRF is the verifiable protocol, in which the server proves that it used the secret key of its public key.
+	ModeVOPRF Mode = 0x01
+)
+
+const (
+	version          = "OPRFV1-"
+	maxLengthPrefix  = 1<<16 - 1
+	maxDeriveCounter = 255
+)
+
+var (
+	// ErrInvalidInput is returned when an input is too long to be length-prefixed on 2 bytes, or maps to the identity.
+	ErrInvalidInput = errors.New("invalid input")
+
+	// ErrDeriveKeyPair is returned when no valid key pair can be derived from a seed.
+	ErrDeriveKeyPair = errors.New("key pair derivation failed")
+
+	// ErrVerify is returned by Finalize when the server's proof is invalid.
+	ErrVerify = errors.New("proof verification failed")
+
+	errInvalidMode = errors.New("invalid mode")
+)
+
+// suite holds the ciphersuite parameters shared by the client and the server.
+type suite struct {
+	context         []byte
+	hashToGroupDST  []byte
+	hashToScalarDST []byte
+	hash            crypto.Hash
+	group           ecc.Group
+	mode            Mode
+}
+
+func newSuite(g ecc.Group, mode Mode) (*suite, error) {
+	var identifier string
+
+	switch g {
+	case ecc.Ristretto255Sha512:
+		identifier = "ristretto255-SHA512"
+	case ecc.P256Sha256:
+		identifier = "P256-SHA256"
+	default:
+		return nil, fmt.Errorf("%w: OPRF for group %s", errors.ErrUnsupported, g)
+	}
+
+	if mode != ModeOPRF && mode != ModeVOPRF {
+		return nil, errInvalidMode
+	}
+
+	// contextString = "OPRFV1-" || I2OSP(mode, 1) || "-" || identifier
+	context := append([]byte(version), byte(mode), '-')
+	context = append(context, identifier...)
+
+	return &suite{
+		context:         context,
+		hashToGroupDST:  append([]byte("HashToGroup-"), context...),
+		hashToScalarDST: append([]byte("HashToScalar-"), context...),
+		hash:            g.HashFunc(),
+		group:           g,
+		mode:            mode,
+	}, nil
+}
+
+// lengthPrefixed appends the 2-byte big-endian length of data and data to dst.
+func lengthPrefixed(dst, data []byte) []byte {
+	return append(binary.BigEndian.AppendUint16(dst, uint16(len(data))), data...)
+}
+
+// hashToGroup returns the element of the input, which must not be the identity.
+func (s *suite) hashToGroup(input []byte) (*ecc.Element, error) {
+	if len(input) > maxLengthPrefix {
+		return nil, ErrInvalidInput
+	}
+
+	e := s.group.HashToGroup(input, s.hashToGroupDST)
+	if e.IsIdentity() {
+		return nil, ErrInvalidInput
+	}
+
+	return e, nil
+}
+
+// output returns the PRF output of the input and its unblinded evaluated element.
+func (s *suite) output(input []byte, unblinded *ecc.Element) []byte {
+	h := s.hash.New()
+	_, _ = h.Write(lengthPrefixed(nil, input))
+	_, _ = h.Write(lengthPrefixed(nil, unblinded.Encode()))
+	_, _ = h.Write([]byte("Finalize"))
+
+	return h.Sum(nil)
+}
+
+// checkElement returns an error if the element is nil, of another group, or the identity.
+func (s *suite) checkElement(e *ecc.Element) error {
+	switch {
+	case e == nil:
+		return internal.ErrParamNilPoint
+	case e.Group() != s.group:
+		return internal.ErrCastElement
+	case e.IsIdentity():
+		return internal.ErrIdentity
+	default:
+		return nil
+	}
+}
+
+// checkScalar returns an error if the scalar is nil, zero, or of another group.
+func (s *suite) checkScalar(k *ecc.Scalar) error {
+	switch {
+	case k == nil || k.IsZero():
+		return internal.ErrParamNilScalar
+	case k.Group() != s.group:
+		return internal.ErrCastScalar
+	default:
+		return nil
+	}
+}
+
+// DeriveKeyPair deterministically derives a server key pair from the seed and the info, which may be empty, as
+// specified in RFC 9497 section 3.2.1. The seed must be secret and uniformly random, of at least ScalarLength bytes.
+func DeriveKeyPair(g ecc.Group, mode Mode, seed, info []byte) (*ecc.Scalar, *ecc.Element, error) {
+	s, err := newSuite(g, mode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("DeriveKeyPair: %w", err)
+	}
+
+	if len(info) > maxLengthPrefix {
+		return nil, nil, fmt.Errorf("DeriveKeyPair: %w", ErrInvalidInput)
+	}
+
+	dst := append([]byte("DeriveKeyPair"), s.context...)
+	input := lengthPrefixed(append([]byte{}, seed...), info)
+
+	for counter := 0; counter <= maxDeriveCounter; counter++ {
+		sk := g.HashToScalar(append(input, byte(counter)), dst)
+		if !sk.IsZero() {
+			return sk, g.Base().Multiply(sk), nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("DeriveKeyPair: %w", ErrDeriveKeyPair)
+}
+
+// composites returns the composite elements M and Z of the proof of section 2.2.1 of RFC 9497. If k is not nil, Z is
+// computed as k * M, as only the prover can.
+func (s *suite) composites(k *ecc.Scalar, b *ecc.Element, c, d []*ecc.Element) (m, z *ecc.Element) {
+	h := s.hash.New()
+	_, _ = h.Write(lengthPrefixed(nil, b.Encode()))
+	_, _ = h.Write(lengthPrefixed(nil, append([]byte("Seed-"), s.context...)))
+	seed := h.Sum(nil)
+
+	m = s.group.NewElement()
+	z = s.group.NewElement()
+
+	for i := range c {
+		transcript := lengthPrefixed(nil, seed)
+		transcript = binary.BigEndian.AppendUint16(transcript, uint16(i))
+		transcript = lengthPrefixed(transcript, c[i].Encode())
+		transcript = lengthPrefixed(transcript, d[i].Encode())
+		transcript = append(transcript, "Composite"...)
+
+		di := s.group.HashToScalar(transcript, s.hashToScalarDST)
+		m.Add(c[i].Copy().Multiply(di))
+
+		if k == nil {
+			z.Add(d[i].Copy().Multiply(di))
+		}
+	}
+
+	if k != nil {
+		z = m.Copy().Multiply(k)
+	}
+
+	return m, z
+}
+
+// challenge returns the challenge scalar of the proof.
+func (s *suite) challenge(b, m, z, t2, t3 *ecc.Element) *ecc.Scalar {
+	transcript := lengthPrefixed(nil, b.Encode())
+	transcript = lengthPrefixed(transcript, m.Encode())
+	transcript = lengthPrefixed(transcript, z.Encode())
+	transcript = lengthPrefixed(transcript, t2.Encode())
+	transcript = lengthPrefixed(transcript, t3.Encode())
+	transcript = append(transcript, "Challenge"...)
+
+	return s.group.HashToScalar(transcript, s.hashToScalarDST)
+}
+
+// generateProof returns the encoding of the proof that log_A(B) = log_C[i](D[i]) = k for all i, with the randomness r.
+func (s *suite) generateProof(k, r *ecc.Scalar, a, b *ecc.Element, c, d []*ecc.Element) []byte {
+	m, z := s.composites(k, b, c, d)
+	t2 := a.Copy().Multiply(r)
+	t3 := m.Copy().Multiply(r)
+	ch := s.challenge(b, m, z, t2, t3)
+	resp := r.Copy().Subtract(ch.Copy().Multiply(k))
+
+	return append(ch.Encode(), resp.Encode()...)
+}
+
+// verifyProof returns whether the proof that log_A(B) = log_C[i](D[i]) for all i is valid. It runs in variable time.
+func (s *suite) verifyProof(a, b *ecc.Element, c, d []*ecc.Element, proof []byte) bool {
+	length := s.group.ScalarLength()
+	if len(proof) != 2*length {
+		return false
+	}
+
+	ch := s.group.NewScalar()
+	if err := ch.Decode(proof[:length]); err != nil {
+		return false
+	}
+
+	resp := s.group.NewScalar()
+	if err := resp.Decode(proof[length:]); err != nil {
+		return false
+	}
+
+	m, z := s.composites(nil, b, c, d)
+	t2 := a.Copy().MultiplyVartime(resp).Add(b.Copy().MultiplyVartime(ch))
+	t3 := m.Copy().MultiplyVartime(resp).Add(z.Copy().MultiplyVartime(ch))
+
+	return s.challenge(b, m, z, t2, t3).Equal(ch)
+}
+
+// Server holds the secret key of an OPRF server.
+type Server struct {
+	*suite
+	secretKey *ecc.Scalar
+	publicKey *ecc.Element
+}
+
+// NewServer returns a server of the mode over the group with the secret key, e.g. from DeriveKeyPair. An error is
+// returned if the group or the mode is not supported, or if the secret key is nil, zero, or of another group.
+func NewServer(g ecc.Group, mode Mode, secretKey *ecc.Scalar) (*Server, error) {
+	s, err := newSuite(g, mode)
+	if err != nil {
+		return nil, fmt.Errorf("NewServer: %w", err)
+	}
+
+	if err = s.checkScalar(secretKey); err != nil {
+		return nil, fmt.Errorf("NewServer: %w", err)
+	}
+
+	return &Server{
+		suite:     s,
+		secretKey: secretKey.Copy(),
+		publicKey: g.Base().Multiply(secretKey),
+	}, nil
+}
+
+// PublicKey returns the server's public key, which clients need in ModeVOPRF.
+func (s *Server) PublicKey() *ecc.Element {
+	return s.publicKey.Copy()
+}
+
+// BlindEvaluate returns the evaluation of the client's blinded element with the secret key. In ModeVOPRF, it also
+// returns the proof of that evaluation, and nil otherwise. An error is returned if the blinded element is nil, the
+// identity, or of another group.
+func (s *Server) BlindEvaluate(blindedElement *ecc.Element) (*ecc.Element, []byte, error) {
+	if err := s.checkElement(blindedElement); err != nil {
+		return nil, nil, fmt.Errorf("BlindEvaluate: %w", err)
+	}
+
+	evaluated := blindedElement.Copy().Multiply(s.secretKey)
+	if s.mode == ModeOPRF {
+		return evaluated, nil, nil
+	}
+
+	r := s.group.NewScalar().Random()
+	proof := s.generateProof(s.secretKey, r, s.group.Base(), s.publicKey,
+		[]*ecc.Element{blindedElement}, []*ecc.Element{evaluated})
+
+	return evaluated, proof, nil
+}
+
+// Evaluate returns the PRF output of the input, as the client would get it, without the blinding round trip.
+func (s *Server) Evaluate(input []byte) ([]byte, error) {
+	e, err := s.hashToGroup(input)
+	if err != nil {
+		return nil, fmt.Errorf("Evaluate: %w", err)
+	}
+
+	return s.output(input, e.Multiply(s.secretKey)), nil
+}
+
+// Client is an OPRF client.
+type Client struct {
+	*suite
+	serverPublicKey *ecc.Element
+}
+
+// NewClient returns a client of the mode over the group. In ModeVOPRF, the server's public key is required to verify
+// its proofs, and is ignored otherwise. An error is returned if the group or the mode is not supported, or, in
+// ModeVOPRF, if the public key is nil, the identity, or of another group.
+func NewClient(g ecc.Group, mode Mode, serverPublicKey *ecc.Element) (*Client, error) {
+	s, err := newSuite(g, mode)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient: %w", err)
+	}
+
+	c := &Client{suite: s}
+
+	if mode == ModeVOPRF {
+		if err = s.checkElement(serverPublicKey); err != nil {
+			return nil, fmt.Errorf("NewClient: %w", err)
+		}
+
+		c.serverPublicKey = serverPublicKey.Copy()
+	}
+
+	return c, nil
+}
+
+// Blind returns a fresh random blind and the blinded element of the input to send to the server. The blind must be
+// kept secret, and is needed to Finalize the server's evaluation. An error is returned if the input is invalid.
+func (c *Client) Blind(input []byte) (blind *ecc.Scalar, blindedElement *ecc.Element, err error) {
+	blind = c.group.NewScalar().Random()
+
+	blindedElement, err = c.DeterministicBlind(input, blind)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return blind, blindedElement, nil
+}
+
+// DeterministicBlind returns the blinded element of the input with the given blind, and is meant for testing. Blinds
+// must be secret, uniformly random, and never reused, as Blind generates them.
+func (c *Client) DeterministicBlind(input []byte, blind *ecc.Scalar) (*ecc.Element, error) {
+	if err := c.checkScalar(blind); err != nil {
+		return nil, fmt.Errorf("Blind: %w", err)
+	}
+
+	e, err := c.hashToGroup(input)
+	if err != nil {
+		return nil, fmt.Errorf("Blind: %w", err)
+	}
+
+	return e.Multiply(blind), nil
+}
+
+// Finalize returns the PRF output of the input, given the blind returned by Blind for that input and the server's
+// evaluation of the blinded element. In ModeVOPRF, the server's proof is verified first, and ErrVerify is returned if
+// it is invalid. The proof is ignored in ModeOPRF.
+func (c *Client) Finalize(input []byte, blind *ecc.Scalar, evaluated *ecc.Element, proof []byte) ([]byte, error) {
+	if err := c.checkElement(evaluated); err != nil {
+		return nil, fmt.Errorf("Finalize: %w", err)
+	}
+
+	blindedElement, err := c.DeterministicBlind(input, blind)
+	if err != nil {
+		return nil, fmt.Errorf("Finalize: %w", err)
+	}
+
+	if c.mode == ModeVOPRF && !c.verifyProof(c.group.Base(), c.serverPublicKey,
+		[]*ecc.Element{blindedElement}, []*ecc.Element{evaluated}, proof) {
+		return nil, fmt.Errorf("Finalize: %w", ErrVerify)
+	}
+
+	unblinded := evaluated.Copy().Multiply(blind.Copy().Invert())
+
+	return c.output(input, unblinded), nil
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal/der"
+)
+
+const (
+	pemPublicKey    = "PUBLIC KEY"
+	pemPrivateKey   = "PRIVATE KEY"
+	pemECPrivateKey = "EC PRIVATE KEY"
+)
+
+var (
+	// ErrPEMBlockType indicates that the type of a PEM block doesn't match the expected key.
+	ErrPEMBlockType = errors.New("unexpected PEM block type")
+
+	errPEMNoBlock = errors.New("no PEM block found")
+)
+
+// MarshalPEM returns the PEM encoding of the element in a PUBLIC KEY block holding its DER encoding, as returned by
+// MarshalDER.
+func (e *Element) MarshalPEM() ([]byte, error) {
+	encoded, err := e.MarshalDER()
+	if err != nil {
+		return nil, fmt.Errorf("element MarshalPEM: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemPublicKey, Bytes: encoded}), nil
+}
+
+// DecodeElementPEM returns the element of the group held by the first PEM block in data, as produced by
+// Element.MarshalPEM, and an error on failure. It returns an error wrapping ErrPEMBlockType if the block is not a
+// PUBLIC KEY, and otherwise the errors of DecodeElementDER.
+func DecodeElementPEM(g Group, data []byte) (*Element, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("DecodeElementPEM: %w", errPEMNoBlock)
+	}
+
+	if block.Type != pemPublicKey {
+		return nil, fmt.Errorf("DecodeElementPEM: %w %q", ErrPEMBlockType, block.Type)
+	}
+
+	e, err := g.decodeElementDER(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeElementPEM: %w", err)
+	}
+
+	return e, nil
+}
+
+// MarshalPEM returns the PEM encoding of the scalar in a PRIVATE KEY block holding its PKCS #8 DER encoding, as
+// returned by MarshalDER.
+func (s *Scalar) MarshalPEM() ([]byte, error) {
+	encoded, err := s.MarshalDER()
+	if err != nil {
+		return nil, fmt.Errorf("scalar MarshalPEM: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: pemPrivateKey, Bytes: encoded}), nil
+}
+
+// DecodeScalarPEM returns the scalar of the group held by the first PEM block in data, and an error on failure. The
+// block is either a PRIVATE KEY holding a PKCS #8 structure, as produced by Scalar.MarshalPEM, or an EC PRIVATE KEY
+// holding a SEC1 ECPrivateKey structure naming its curve, as produced by OpenSSL. It returns an error wrapping
+// ErrPEMBlockType for other block types, and otherwise the errors of DecodeScalarDER.
+func DecodeScalarPEM(g Group, data []byte) (*Scalar, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("DecodeScalarPEM: %w", errPEMNoBlock)
+	}
+
+	var parse func([]byte) (der.Algorithm, []byte, error)
+
+	switch block.Type {
+	case pemPrivateKey:
+		parse = der.ParsePrivateKey
+	case pemECPrivateKey:
+		parse = der.ParseECPrivateKey
+	default:
+		return nil, fmt.Errorf("DecodeScalarPEM: %w %q", ErrPEMBlockType, block.Type)
+	}
+
+	s, err := g.decodeScalarDER(block.Bytes, parse)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeScalarPEM: %w", err)
+	}
+
+	return s, nil
+}
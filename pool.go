@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"sync"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+var (
+	scalarPools  [maxID - 1]sync.Pool
+	elementPools [maxID - 1]sync.Pool
+)
+
+// GetScalar returns a scalar set to 0, like NewScalar, but reusing one returned with PutScalar if there's any, to reduce
+// allocations in hot loops.
+func (g Group) GetScalar() *Scalar {
+	if !g.Available() {
+		panic(internal.ErrInvalidGroup)
+	}
+
+	if s, ok := scalarPools[g-1].Get().(*Scalar); ok {
+		return s
+	}
+
+	return g.NewScalar()
+}
+
+// PutScalar sets the scalar to 0, so that its value doesn't leak to the next GetScalar, and returns it to the group's
+// pool. The scalar, and any reference to it, must not be used after that. A nil scalar is ignored. It panics if the
+// scalar does not belong to the group.
+func (g Group) PutScalar(s *Scalar) {
+	if s == nil {
+		return
+	}
+
+	if s.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+
+	s.Zero()
+	scalarPools[g-1].Put(s)
+}
+
+// GetElement returns the identity element, like NewElement, but reusing one returned with PutElement if there's any,
+// to reduce allocations in hot loops.
+func (g Group) GetElement() *Element {
+	if !g.Available() {
+		panic(internal.ErrInvalidGroup)
+	}
+
+	if e, ok := elementPools[g-1].Get().(*Element); ok {
+		return e
+	}
+
+	return g.NewElement()
+}
+
+// PutElement sets the element to the identity and returns it to the group's pool. The element, and any reference to
+// it, must not be used after that. A nil element is ignored. It panics if the element does not belong to the group.
+func (g Group) PutElement(e *Element) {
+	if e == nil {
+		return
+	}
+
+	if e.Group() != g {
+		panic(internal.ErrCastElement)
+	}
+
+	e.Element.Identity()
+	elementPools[g-1].Put(e)
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"crypto/subtle"
+	"sync"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// PrecomputeWindow is the width, in bits, of the scalar digits indexing the tables of PrecomputedElement, including the
+// base point tables of Group.BaseTable. Each table holds 2^PrecomputeWindow elements per digit of the group order.
+const PrecomputeWindow = 4
+
+var (
+	baseTablesOnce [maxID - 1]sync.Once
+	baseTables     [maxID - 1]*PrecomputedElement
+)
+
+// PrecomputedElement holds a table of multiples of a fixed element, for faster repeated multiplications of that element,
+// e.g. the second generator of Pedersen commitments. Building the table costs as much as 4 to 10 multiplications,
+// and each multiplication then only costs one addition per 4 bits of scalar, and no doublings.
+type PrecomputedElement struct {
+	group Group
+
+	// table[i][d] = d * 2^(PrecomputeWindow*i) * element.
+	table [][]*Element
+}
+
+// Precompute returns a new PrecomputedElement for the element, which is not modified and can be reused.
+func (e *Element) Precompute() *PrecomputedElement {
+	g := e.Group()
+	rows := (g.scalarBitLen() + PrecomputeWindow - 1) / PrecomputeWindow
+	p := &PrecomputedElement{
+		group: g,
+		table: make([][]*Element, rows),
+	}
+
+	base := e.Copy()
+
+	for i := range p.table {
+		row := make([]*Element, 1<<PrecomputeWindow)
+		row[0] = g.NewElement()
+		row[1] = base.Copy()
+
+		for d := 2; d < len(row); d++ {
+			row[d] = row[d-1].Copy().Add(base)
+		}
+
+		p.table[i] = row
+
+		for range PrecomputeWindow {
+			base.Double()
+		}
+	}
+
+	return p
+}
+
+// Group returns the group's Identifier.
+func (p *PrecomputedElement) Group() Group {
+	return p.group
+}
+
+// Element returns a copy of the element the table was built for.
+func (p *PrecomputedElement) Element() *Element {
+	return p.table[0][1].Copy()
+}
+
+// Multiply returns a new element set to the product of the scalar and the precomputed element, as the sum of one table
+// entry per digit of the scalar. A nil scalar returns the identity. Every entry of a row is read with Element.CMov to
+// select the one for a digit, and an entry is added for every digit, including zero digits, so that neither the memory
+// accesses nor the sequence of operations depend on the scalar. This is only as constant time as the group's CMov and
+// Add, which isn't the case for the big.Int based backends.
+func (p *PrecomputedElement) Multiply(scalar *Scalar) *Element {
+	if scalar == nil {
+		return p.group.NewElement()
+	}
+
+	if scalar.Group() != p.group {
+		panic(internal.ErrCastScalar)
+	}
+
+	encoded := p.group.littleEndianScalar(scalar.Scalar)
+	result := p.group.NewElement()
+	entry := p.group.NewElement()
+
+	for i, row := range p.table {
+		selectEntry(entry, row, scalarDigit(encoded, i*PrecomputeWindow, PrecomputeWindow))
+		result.Add(entry)
+	}
+
+	return result
+}
+
+// multiplyAll returns the products of the precomputed element with each of the scalars, which must be of the group.
+// The table is walked once for all of them, i.e. each row is read for every scalar before moving on to the next one,
+// and entries are selected and added as in Multiply. The products are then normalized with a single field inversion
+// shared by all of them, if the backend keeps its elements in projective coordinates.
+func (p *PrecomputedElement) multiplyAll(scalars []*Scalar) []*Element {
+	encoded := make([][]byte, len(scalars))
+	results := make([]*Element, len(scalars))
+
+	for k, s := range scalars {
+		results[k] = p.group.NewElement()
+
+		if s != nil {
+			encoded[k] = p.group.littleEndianScalar(s.Scalar)
+		}
+	}
+
+	entry := p.group.NewElement()
+
+	for i, row := range p.table {
+		for k, e := range encoded {
+			if e == nil {
+				continue
+			}
+
+			selectEntry(entry, row, scalarDigit(e, i*PrecomputeWindow, PrecomputeWindow))
+			results[k].Add(entry)
+		}
+	}
+
+	if n, ok := p.group.get().(internal.BatchNormalizer); ok {
+		elements := make([]internal.Element, len(results))
+		for k, r := range results {
+			elements[k] = r.Element
+		}
+
+		n.BatchNormalize(elements)
+	}
+
+	return results
+}
+
+// selectEntry sets entry to row[d], reading every entry of the row with Element.CMov.
+func selectEntry(entry *Element, row []*Element, d int) {
+	for j, e := range row {
+		entry.CMov(e, subtle.ConstantTimeEq(int32(j), int32(d)))
+	}
+}
+
+// BaseTable returns the table of multiples of the group's base point, which is built at the first call and then shared.
+// ScalarBaseMult, and thus Multiply on the base point, use it for the groups whose backends don't have their own
+// precomputed tables, i.e. BLS12-381 G1, Pallas, and Vesta.
+func (g Group) BaseTable() *PrecomputedElement {
+	baseTablesOnce[g-1].Do(func() {
+		baseTables[g-1] = g.Base().Precompute()
+	})
+
+	return baseTables[g-1]
+}
+
+// hasBaseTable returns whether the group's backend multiplies the base point with its own precomputed table, which is
+// faster than BaseTable.
+func (g Group) hasBaseTable() bool {
+	return g != BLS12381G1Sha256 && g != PallasSha256 && g != VestaSha256
+}
@@ -9,12 +9,36 @@
 package ecc
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"math/big"
+	"math/bits"
 	"strings"
+	"sync"
 
 	"github.com/0xBridge/ecc/internal"
 )
 
+const (
+	// uniformSecurityLength is the number of bytes the uniform strings reduced to scalars by RandomFromReader and
+	// DeriveScalar have on top of the scalar length, so that the statistical distance of the reduction to the uniform
+	// distribution is below 2^-128.
+	uniformSecurityLength = 16
+
+	// maxRandomAttempts is the number of zero scalars RandomFromReader draws before giving up on the reader.
+	maxRandomAttempts = 8
+)
+
+var (
+	errRandomZero = errors.New("random source only produced zero scalars")
+
+	halfOrdersOnce [maxID - 1]sync.Once
+	halfOrders     [maxID - 1][]byte
+)
+
 // Scalar represents a scalar in the prime-order group.
 type Scalar struct {
 	_ disallowEqual
@@ -55,6 +79,27 @@ func (s *Scalar) Random() *Scalar {
 	return s
 }
 
+// RandomFromReader sets the scalar to a random non-zero scalar drawn from r instead of crypto/rand, and returns it,
+// e.g. to reproduce key generation or test vectors from a seeded stream. It reads ScalarLength + 16 bytes at a time,
+// which it reduces modulo the order as a big-endian integer, and reads again if the scalar is zero. An error is
+// returned if reading from r fails, or if it only yields zero scalars.
+func (s *Scalar) RandomFromReader(r io.Reader) (*Scalar, error) {
+	g := s.Group()
+	uniform := make([]byte, g.ScalarLength()+uniformSecurityLength)
+
+	for range maxRandomAttempts {
+		if _, err := io.ReadFull(r, uniform); err != nil {
+			return nil, fmt.Errorf("RandomFromReader: %w", err)
+		}
+
+		if !s.SetBigInt(new(big.Int).SetBytes(uniform)).IsZero() {
+			return s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("RandomFromReader: %w", errRandomZero)
+}
+
 // Add sets the receiver to the sum of the input and the receiver, and returns the receiver.
 func (s *Scalar) Add(scalar *Scalar) *Scalar {
 	if scalar == nil {
@@ -88,7 +133,78 @@ func (s *Scalar) Multiply(scalar *Scalar) *Scalar {
 	return s
 }
 
+// AddInto sets s to a + b, and returns s, without modifying a or b unless one of them is s. It panics if a or b is nil
+// or does not belong to the group of s.
+func (s *Scalar) AddInto(a, b *Scalar) *Scalar {
+	s.checkOperands(a, b)
+
+	if s == b {
+		s.Scalar.Add(a.Scalar)
+		return s
+	}
+
+	s.Scalar.Set(a.Scalar)
+	s.Scalar.Add(b.Scalar)
+
+	return s
+}
+
+// MultiplyInto sets s to a * b, and returns s, without modifying a or b unless one of them is s. It panics if a or b is
+// nil or does not belong to the group of s.
+func (s *Scalar) MultiplyInto(a, b *Scalar) *Scalar {
+	s.checkOperands(a, b)
+
+	if s == b {
+		s.Scalar.Multiply(a.Scalar)
+		return s
+	}
+
+	s.Scalar.Set(a.Scalar)
+	s.Scalar.Multiply(b.Scalar)
+
+	return s
+}
+
+// checkOperands panics if a or b is nil or does not belong to the group of s.
+func (s *Scalar) checkOperands(a, b *Scalar) {
+	checkScalars(a, b)
+
+	if g := s.Group(); a.Group() != g || b.Group() != g {
+		panic(internal.ErrCastScalar)
+	}
+}
+
+// AddUint64 sets s to s + n modulo the group order, and returns s. It is the same as s.Add(NewScalar().SetUInt64(n)).
+func (s *Scalar) AddUint64(n uint64) *Scalar {
+	s.Scalar.Add(s.Group().NewScalar().SetUInt64(n).Scalar)
+	return s
+}
+
+// MulUint64 sets s to s * n modulo the group order, and returns s. It is the same as
+// s.Multiply(NewScalar().SetUInt64(n)).
+func (s *Scalar) MulUint64(n uint64) *Scalar {
+	s.Scalar.Multiply(s.Group().NewScalar().SetUInt64(n).Scalar)
+	return s
+}
+
+// MultiplyAdd sets the receiver to s * a + b modulo the group order, and returns the receiver. Following the conventions
+// of Multiply and Add, a nil a yields a zero product (and thus sets the receiver to b), and a nil b adds nothing.
+func (s *Scalar) MultiplyAdd(a, b *Scalar) *Scalar {
+	if a == nil {
+		return s.Set(b)
+	}
+
+	if b == nil {
+		return s.Multiply(a)
+	}
+
+	s.Scalar.MultiplyAdd(a.Scalar, b.Scalar)
+
+	return s
+}
+
 // Pow sets s to s**scalar modulo the group order, and returns s. If scalar is nil, it returns 1.
+// By convention, 0**0 = 1, and 0**k = 0 for k > 0.
 func (s *Scalar) Pow(scalar *Scalar) *Scalar {
 	if scalar == nil {
 		return s.One()
@@ -99,12 +215,106 @@ func (s *Scalar) Pow(scalar *Scalar) *Scalar {
 	return s
 }
 
-// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it.
+// PowUint64 sets s to s**exp modulo the group order, and returns s. If exp is 0, it returns 1, and as with Pow, 0**0 = 1.
+func (s *Scalar) PowUint64(exp uint64) *Scalar {
+	base := s.Scalar.Copy()
+	s.Scalar.One()
+
+	// Left-to-right square-and-multiply over the bits of the exponent.
+	for i := bits.Len64(exp) - 1; i >= 0; i-- {
+		s.Scalar.Multiply(s.Scalar)
+
+		if (exp>>uint(i))&1 == 1 {
+			s.Scalar.Multiply(base)
+		}
+	}
+
+	return s
+}
+
+// Invert sets the receiver to the scalar's modular inverse ( 1 / scalar ), and returns it. The inverse of 0 is defined
+// as 0, rather than panicking.
 func (s *Scalar) Invert() *Scalar {
 	s.Scalar.Invert()
 	return s
 }
 
+// halfOrder returns the little-endian encoding of (order + 1) / 2, i.e. of the inverse of 2, which is computed at the
+// first call and then shared.
+func (g Group) halfOrder() []byte {
+	halfOrdersOnce[g-1].Do(func() {
+		half := new(big.Int).Add(g.OrderBigInt(), big.NewInt(1))
+		halfOrders[g-1] = internal.Reverse(half.Rsh(half, 1).FillBytes(make([]byte, g.ScalarLength())))
+	})
+
+	return halfOrders[g-1]
+}
+
+// Halve sets s to s / 2 modulo the group order, and returns s. An even s is shifted right by one bit, and an odd s is
+// shifted and then added (order + 1) / 2, which amounts to shifting s + order. Both are done on the encoding of s in a
+// single pass, where the parity of s masks the bytes of (order + 1) / 2, and the result, which is below the order,
+// is decoded back. No branch or memory access thus depends on s, but this is only as constant time as the group's
+// scalar encoding and decoding, which isn't the case for the big.Int based backends.
+func (s *Scalar) Halve() *Scalar {
+	g := s.Group()
+	encoded := g.littleEndianScalar(s.Scalar)
+	half := g.halfOrder()
+	mask := -(encoded[0] & 1)
+
+	var carry uint16
+
+	for i := range encoded {
+		shifted := encoded[i] >> 1
+		if i+1 < len(encoded) {
+			shifted |= encoded[i+1] << 7
+		}
+
+		sum := uint16(shifted) + uint16(half[i]&mask) + carry
+		encoded[i], carry = byte(sum), sum>>8
+	}
+
+	if !g.littleEndianScalars() {
+		encoded = internal.Reverse(encoded)
+	}
+
+	if err := s.Scalar.Decode(encoded); err != nil {
+		// This cannot happen, since (s + order) / 2 is below the order for any s below the order.
+		panic(fmt.Sprintf("unexpected decoding of halved scalar: %s", err))
+	}
+
+	return s
+}
+
+// CondNegate sets s to -s if choice is 1, leaves it unchanged if choice is 0, and returns s. It subtracts
+// 2 * choice * s from s, so that no branch depends on choice. The behavior is undefined for other values of choice.
+func (s *Scalar) CondNegate(choice int) *Scalar {
+	t := s.Scalar.Copy().Multiply(s.Group().NewScalar().SetUInt64(2 * uint64(choice&1)).Scalar)
+	s.Scalar.Subtract(t)
+
+	return s
+}
+
+// CSwap exchanges the values of s and scalar if choice is 1, and leaves them unchanged if choice is 0. It moves
+// d = choice * (s - scalar) from s to scalar, so that no branch depends on choice. The behavior is undefined for other
+// values of choice.
+func (s *Scalar) CSwap(scalar *Scalar, choice int) {
+	if scalar == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	d := s.Scalar.Copy().Subtract(scalar.Scalar)
+	d.Multiply(s.Group().NewScalar().SetUInt64(uint64(choice & 1)).Scalar)
+
+	s.Scalar.Subtract(d)
+	scalar.Scalar.Add(d)
+}
+
+// ActOnBase returns a new element set to the product of the group's base point and s, e.g. the public key of the secret
+// s. It is the same as Base().Multiply(s), but goes through ScalarBaseMult without allocating the base point.
+func (s *Scalar) ActOnBase() *Element {
+	return s.Group().ScalarBaseMult(s)
+}
+
 // Equal returns true if the elements are equivalent, and false otherwise.
 func (s *Scalar) Equal(scalar *Scalar) bool {
 	if scalar == nil {
@@ -123,6 +333,24 @@ func (s *Scalar) LessOrEqual(scalar *Scalar) bool {
 	return s.Scalar.LessOrEqual(scalar.Scalar) == 1
 }
 
+// Cmp returns -1 if s < scalar, 0 if s == scalar, and +1 if s > scalar. It compares the canonical integer values of the
+// scalars independently of the group's encoding endianness, and runs in constant time with regard to these values.
+// As with Equal and LessOrEqual, a nil scalar is not an error: s compares greater than it, and Cmp returns +1. Like
+// them, Cmp panics if scalar belongs to another group.
+func (s *Scalar) Cmp(scalar *Scalar) int {
+	if scalar == nil {
+		return 1
+	}
+
+	return s.Scalar.Cmp(scalar.Scalar)
+}
+
+// Bit returns the i-th bit (0 or 1) of the canonical integer value of s, where bit 0 is always the least significant
+// bit regardless of the group's encoding endianness. It returns 0 if i is out of range.
+func (s *Scalar) Bit(i int) int {
+	return s.Scalar.Bit(i)
+}
+
 // IsZero returns whether the scalar is 0.
 func (s *Scalar) IsZero() bool {
 	return s.Scalar.IsZero()
@@ -145,6 +373,19 @@ func (s *Scalar) SetUInt64(i uint64) *Scalar {
 	return s
 }
 
+// SetBigInt sets s to i modulo the group order, and returns s. A negative i is reduced to the non-negative residue, so
+// that it yields the negation of -i. A nil i sets s to 0.
+func (s *Scalar) SetBigInt(i *big.Int) *Scalar {
+	if i == nil {
+		return s.Zero()
+	}
+
+	g := s.Group()
+	s.Scalar.Set(g.bigIntToScalar(new(big.Int).Mod(i, g.OrderBigInt())))
+
+	return s
+}
+
 // UInt64 returns the uint64 representation of the scalar,
 // or an error if its value is higher than the authorized limit for uint64.
 func (s *Scalar) UInt64() (uint64, error) {
@@ -161,15 +402,27 @@ func (s *Scalar) Copy() *Scalar {
 	return &Scalar{Scalar: s.Scalar.Copy()}
 }
 
+// SetBytesReduced sets s to the big-endian unsigned integer in, of any length, reduced modulo the group order, and
+// returns s. Contrary to Decode, it accepts any input length and values above the order, and is meant for importing
+// existing key material. The output is only uniformly distributed if the input is uniformly random and at least
+// ScalarLength() + 16 bytes long: shorter inputs can only represent part of the scalar field (e.g. a 20-byte input
+// is always below 2^160), and inputs close to the order's size are biased towards small values. Avoiding this bias is
+// the caller's responsibility; use HashToScalar to map arbitrary input to a uniform scalar.
+func (s *Scalar) SetBytesReduced(in []byte) *Scalar {
+	s.Scalar.SetBytesReduced(in)
+	return s
+}
+
 // Encode returns the compressed byte encoding of the scalar.
 func (s *Scalar) Encode() []byte {
 	return s.Scalar.Encode()
 }
 
-// Decode sets the receiver to a decoding of the input data, and returns an error on failure.
+// Decode sets the receiver to a decoding of the input data, and returns an error on failure. On failure, the receiver
+// is left unchanged, never half-decoded, and so it is for all the decoding methods of Scalar.
 func (s *Scalar) Decode(data []byte) error {
 	if err := s.Scalar.Decode(data); err != nil {
-		return fmt.Errorf("scalar Decode: %w", err)
+		return fmt.Errorf("scalar Decode: %w", scalarDecodingError(err))
 	}
 
 	return nil
@@ -180,10 +433,107 @@ func (s *Scalar) Hex() string {
 	return s.Scalar.Hex()
 }
 
+// String returns the name of the scalar's group and its hexadecimal encoding, e.g. "P256Sha256:00ab...", so that
+// scalars are readable and tagged with their group when printed with fmt. Beware of printing secret scalars in logs.
+func (s *Scalar) String() string {
+	return s.Group().name() + ":" + s.Hex()
+}
+
 // DecodeHex sets s to the decoding of the hex encoded scalar.
 func (s *Scalar) DecodeHex(h string) error {
 	if err := s.Scalar.DecodeHex(h); err != nil {
-		return fmt.Errorf("scalar DecodeHex: %w", err)
+		return fmt.Errorf("scalar DecodeHex: %w", scalarDecodingError(err))
+	}
+
+	return nil
+}
+
+// PowBig sets s to s**exp modulo the group order, and returns s. As the order is prime, s**exp only depends on exp
+// modulo order - 1 for a non-zero s, so exp is reduced modulo order - 1 to a non-zero exponent if exp is not zero. A
+// negative exp is the exponent of the inverse of s, with the inverse of 0 being 0. As with Pow, 0**0 = 1.
+func (s *Scalar) PowBig(exp *big.Int) *Scalar {
+	if exp == nil || exp.Sign() == 0 {
+		return s.One()
+	}
+
+	if exp.Sign() < 0 {
+		s.Invert()
+	}
+
+	g := s.Group()
+	orderMinusOne := new(big.Int).Sub(g.OrderBigInt(), big.NewInt(1))
+
+	// e = 1 + ((|exp| - 1) mod (order - 1)) is in [1, order - 1], and equals |exp| modulo order - 1.
+	e := new(big.Int).Abs(exp)
+	e.Sub(e, big.NewInt(1)).Mod(e, orderMinusOne).Add(e, big.NewInt(1))
+
+	s.Scalar.Pow(g.bigIntToScalar(e))
+
+	return s
+}
+
+// bigIntToScalar returns the scalar of the integer i, which must be in [0, order).
+func (g Group) bigIntToScalar(i *big.Int) internal.Scalar {
+	encoded := i.FillBytes(make([]byte, g.ScalarLength()))
+	if g.littleEndianScalars() {
+		encoded = internal.Reverse(encoded)
+	}
+
+	s := g.NewScalar()
+	if err := s.Scalar.Decode(encoded); err != nil {
+		// This cannot happen, since i is reduced.
+		panic(fmt.Sprintf("unexpected decoding of reduced scalar: %s", err))
+	}
+
+	return s.Scalar
+}
+
+// Text returns the canonical integer value of s in the given base, like big.Int.Text, regardless of the group's encoding
+// endianness, e.g. in decimal for base 10. The base must be between 2 and 62.
+func (s *Scalar) Text(base int) string {
+	return new(big.Int).SetBytes(internal.Reverse(s.Group().littleEndianScalar(s.Scalar))).Text(base)
+}
+
+// SetString sets s to the integer value of str in the given base, as interpreted by big.Int.SetString, and returns an
+// error matching ErrInvalidScalarEncoding if str is not a valid integer or not in [0, order), leaving s untouched.
+func (s *Scalar) SetString(str string, base int) error {
+	g := s.Group()
+
+	i, ok := new(big.Int).SetString(str, base)
+	if !ok || i.Sign() < 0 || i.Cmp(g.OrderBigInt()) >= 0 {
+		return fmt.Errorf("scalar SetString: %w", internal.ErrParamScalarInvalidEncoding)
+	}
+
+	encoded := i.FillBytes(make([]byte, g.ScalarLength()))
+	if g.littleEndianScalars() {
+		encoded = internal.Reverse(encoded)
+	}
+
+	if err := s.Scalar.Decode(encoded); err != nil {
+		return fmt.Errorf("scalar SetString: %w", scalarDecodingError(err))
+	}
+
+	return nil
+}
+
+// Base64 returns the unpadded base64url encoding of s.
+func (s *Scalar) Base64() string {
+	return base64.RawURLEncoding.EncodeToString(s.Scalar.Encode())
+}
+
+// DecodeBase64 sets s to the decoding of the unpadded base64url encoded scalar.
+func (s *Scalar) DecodeBase64(b string) error {
+	d, err := base64.RawURLEncoding.DecodeString(b)
+	if err != nil {
+		return fmt.Errorf("scalar DecodeBase64: %w", scalarDecodingError(err))
+	}
+
+	if len(d) != s.Group().ScalarLength() {
+		return fmt.Errorf("scalar DecodeBase64: %w", scalarDecodingError(internal.ErrParamScalarLength))
+	}
+
+	if err = s.Scalar.Decode(d); err != nil {
+		return fmt.Errorf("scalar DecodeBase64: %w", scalarDecodingError(err))
 	}
 
 	return nil
@@ -200,6 +550,32 @@ func (s *Scalar) UnmarshalJSON(data []byte) error {
 	return s.DecodeHex(j)
 }
 
+// MarshalText implements the encoding.TextMarshaler interface, and returns the hexadecimal encoding of s.
+func (s *Scalar) MarshalText() ([]byte, error) {
+	return []byte(s.Hex()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, and sets s to the decoding of the hex encoded text.
+func (s *Scalar) UnmarshalText(text []byte) error {
+	if err := s.Scalar.DecodeHex(string(text)); err != nil {
+		return fmt.Errorf("scalar UnmarshalText: %w", scalarDecodingError(err))
+	}
+
+	return nil
+}
+
+// AppendBinary appends the fixed-length byte encoding of s to b, and returns the extended buffer. It implements
+// encoding.BinaryAppender, sparing the copy of MarshalBinary when serializing many values into one buffer.
+func (s *Scalar) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, s.Scalar.Encode()...), nil
+}
+
+// AppendText appends the fixed-length hexadecimal encoding of s to b, and returns the extended buffer. It implements
+// encoding.TextAppender.
+func (s *Scalar) AppendText(b []byte) ([]byte, error) {
+	return hex.AppendEncode(b, s.Scalar.Encode()), nil
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
 func (s *Scalar) MarshalBinary() ([]byte, error) {
 	return s.Scalar.Encode(), nil
@@ -208,7 +584,7 @@ func (s *Scalar) MarshalBinary() ([]byte, error) {
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
 func (s *Scalar) UnmarshalBinary(data []byte) error {
 	if err := s.Scalar.Decode(data); err != nil {
-		return fmt.Errorf("scalar UnmarshalBinary: %w", err)
+		return fmt.Errorf("scalar UnmarshalBinary: %w", scalarDecodingError(err))
 	}
 
 	return nil
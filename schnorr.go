@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	schnorrApp     = "ECC-Schnorr"
+	schnorrVersion = 1
+
+	// schnorrNonceEntropy is the byte length of the randomness mixed into the nonce derivation.
+	schnorrNonceEntropy = 32
+)
+
+// Schnorr implements Schnorr signatures over a Group, with a secret key scalar x and its public key element X = x * G,
+// where G is the group's base point. A signature on a message m is the encoding of R || z, where R = k * G for a
+// hedged nonce k derived from fresh randomness, the secret key, and the message, and z = k + c * x with the challenge
+// c = HashToScalar(R || X || m) under a DST bound to the group.
+//
+// Verification checks z * G = R + c * X. In groups with a cofactor, i.e. Edwards25519, Edwards448, and BLS12-381 G1,
+// both sides are multiplied by the cofactor so that single and batch verification accept the same signatures.
+//
+// Signatures are not compatible with other Schnorr schemes, like EdDSA or BIP340 over secp256k1, which use other
+// challenge derivations and encodings.
+type Schnorr struct {
+	nonceDST     []byte
+	challengeDST []byte
+	group        Group
+}
+
+// NewSchnorr returns a Schnorr signature scheme over the group. It panics if the group is not available.
+func NewSchnorr(g Group) *Schnorr {
+	return &Schnorr{
+		nonceDST:     g.MakeDST(schnorrApp+"-nonce", schnorrVersion),
+		challengeDST: g.MakeDST(schnorrApp+"-challenge", schnorrVersion),
+		group:        g,
+	}
+}
+
+// Group returns the group of the signature scheme.
+func (s *Schnorr) Group() Group {
+	return s.group
+}
+
+// SignatureLength returns the byte size of a signature, i.e. the sum of the element and scalar lengths.
+func (s *Schnorr) SignatureLength() int {
+	return s.group.ElementLength() + s.group.ScalarLength()
+}
+
+// challenge returns the challenge scalar binding the commitment, the public key, and the message.
+func (s *Schnorr) challenge(r, pub *Element, message []byte) *Scalar {
+	transcript := make([]byte, 0, 2*s.group.ElementLength()+len(message))
+	transcript = append(transcript, r.Encode()...)
+	transcript = append(transcript, pub.Encode()...)
+	transcript = append(transcript, message...)
+
+	return s.group.HashToScalar(transcript, s.challengeDST)
+}
+
+// Sign returns the signature of the message with the secret key. An error is returned if the secret key is nil, zero,
+// or of another group.
+func (s *Schnorr) Sign(secret *Scalar, message []byte) ([]byte, error) {
+	if secret == nil || secret.IsZero() {
+		return nil, fmt.Errorf("Schnorr Sign: %w", internal.ErrParamNilScalar)
+	}
+
+	if secret.Group() != s.group {
+		return nil, fmt.Errorf("Schnorr Sign: %w", internal.ErrCastScalar)
+	}
+
+	pub := s.group.ScalarBaseMult(secret)
+
+	// The nonce is hedged: it stays secret with a weak random source, and differs across signatures of the message.
+	input := internal.RandomBytes(schnorrNonceEntropy)
+	input = append(input, secret.Encode()...)
+	input = append(input, pub.Encode()...)
+	input = append(input, message...)
+
+	k := s.group.HashToScalar(input, s.nonceDST)
+	r := s.group.ScalarBaseMult(k)
+	z := s.challenge(r, pub, message).MultiplyAdd(secret, k)
+
+	return append(r.Encode(), z.Encode()...), nil
+}
+
+// decodeSignature returns the commitment and response of the signature, and whether it is well-formed.
+func (s *Schnorr) decodeSignature(signature []byte) (*Element, *Scalar, bool) {
+	if len(signature) != s.SignatureLength() {
+		return nil, nil, false
+	}
+
+	r := s.group.NewElement()
+	if err := r.Decode(signature[:s.group.ElementLength()]); err != nil {
+		return nil, nil, false
+	}
+
+	z := s.group.NewScalar()
+	if err := z.Decode(signature[s.group.ElementLength():]); err != nil {
+		return nil, nil, false
+	}
+
+	return r, z, true
+}
+
+// validPublicKey returns whether the public key is a non-identity element of the group.
+func (s *Schnorr) validPublicKey(pub *Element) bool {
+	return pub != nil && pub.Group() == s.group && !pub.IsIdentity()
+}
+
+// Verify returns whether the signature of the message is valid for the public key. It runs in variable time, and
+// returns false for a nil or identity public key, or one of another group.
+func (s *Schnorr) Verify(pub *Element, message, signature []byte) bool {
+	if !s.validPublicKey(pub) {
+		return false
+	}
+
+	r, z, ok := s.decodeSignature(signature)
+	if !ok {
+		return false
+	}
+
+	c := s.challenge(r, pub, message)
+
+	// z * G - c * X - R must be the identity.
+	d := s.group.DoubleScalarBaseMultBase(z, s.group.NewScalar().Subtract(c), pub).Subtract(r)
+
+	return d.ClearCofactor().IsIdentity()
+}
+
+// VerifyBatch returns whether all the signatures of the messages are valid for their respective public keys, verifying
+// them at once with a random linear combination, which is faster than verifying them one by one. It runs in variable
+// time, and returns false if the slices are empty or have different lengths. If it returns false, Verify tells which
+// signatures are invalid.
+func (s *Schnorr) VerifyBatch(pubs []*Element, messages, signatures [][]byte) bool {
+	n := len(pubs)
+	if n == 0 || len(messages) != n || len(signatures) != n {
+		return false
+	}
+
+	// sum(a_i * z_i) * G - sum(a_i * c_i * X_i) - sum(a_i * R_i) must be the identity, for random weights a_i.
+	scalars := make([]*Scalar, 0, 2*n+1)
+	elements := make([]*Element, 0, 2*n+1)
+	sum := s.group.NewScalar()
+
+	for i, pub := range pubs {
+		if !s.validPublicKey(pub) {
+			return false
+		}
+
+		r, z, ok := s.decodeSignature(signatures[i])
+		if !ok {
+			return false
+		}
+
+		a := s.group.NewScalar().Random()
+		c := s.challenge(r, pub, messages[i])
+		sum.Add(a.Copy().Multiply(z))
+
+		scalars = append(scalars, s.group.NewScalar().Subtract(c.Multiply(a)), s.group.NewScalar().Subtract(a))
+		elements = append(elements, pub, r)
+	}
+
+	scalars = append(scalars, sum)
+	elements = append(elements, s.group.Base())
+
+	return s.group.MultiScalarMult(scalars, elements).ClearCofactor().IsIdentity()
+}
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+// Package sss implements Shamir's secret sharing over the scalar field of any ecc group: a secret scalar is split into
+// shares such that any threshold of them recover it, while fewer reveal nothing about it. Feldman's verifiable secret
+// sharing additionally lets the recipients verify their shares against public commitments to the polynomial.
+package sss
+
+import (
+	"errors"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+var (
+	errInvalidThreshold = errors.New("threshold must be positive and at most the number of shares")
+	errNoShares         = errors.New("no shares to recover from")
+	errZeroIndex        = errors.New("share index must not be zero")
+	errDuplicateIndex   = errors.New("duplicate share index")
+)
+
+// Share is the evaluation of the secret sharing polynomial at a non-zero index.
+type Share struct {
+	Value *ecc.Scalar
+	Index uint64
+}
+
+// Split returns the shares of the secret at the indexes 1 to shares, i.e. the evaluations of a random polynomial of
+// degree threshold - 1 whose constant term is the secret. Any threshold of them recover the secret with Recover. It
+// panics if the secret is nil, or if threshold is not positive or larger than shares.
+func Split(secret *ecc.Scalar, threshold, shares int) []Share {
+	out, _ := split(secret, threshold, shares)
+	return out
+}
+
+// SplitVerifiable returns the shares of the secret like Split, and Feldman's commitments to the polynomial
+// coefficients, i.e. a_j * G for the coefficient a_j of degree j and the base point G, with which the recipients can
+// verify their shares with VerifyShare. The first commitment is the public key of the secret. It panics like Split.
+func SplitVerifiable(secret *ecc.Scalar, threshold, shares int) ([]Share, []*ecc.Element) {
+	out, coefficients := split(secret, threshold, shares)
+	g := secret.Group()
+	commitments := make([]*ecc.Element, len(coefficients))
+
+	for i, a := range coefficients {
+		commitments[i] = g.ScalarBaseMult(a)
+	}
+
+	return out, commitments
+}
+
+func split(secret *ecc.Scalar, threshold, shares int) ([]Share, []*ecc.Scalar) {
+	if secret == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	if threshold < 1 || shares < threshold {
+		panic(errInvalidThreshold)
+	}
+
+	g := secret.Group()
+	coefficients := make([]*ecc.Scalar, threshold)
+	coefficients[0] = secret.Copy()
+
+	for i := 1; i < threshold; i++ {
+		coefficients[i] = g.NewScalar().Random()
+	}
+
+	out := make([]Share, shares)
+	for i := range out {
+		index := uint64(i) + 1
+		out[i] = Share{
+			Value: evaluate(coefficients, g.NewScalar().SetUInt64(index)),
+			Index: index,
+		}
+	}
+
+	return out, coefficients
+}
+
+// VerifyShare returns whether the share is consistent with the commitments of SplitVerifiable, i.e. whether
+// share * G equals the sum of index^j * C_j over the commitments C_j. It runs in variable time, and returns false if
+// there are no commitments, if the index is zero, or if the share or a commitment is nil or of another group.
+func VerifyShare(share Share, commitments []*ecc.Element) bool {
+	if len(commitments) == 0 || share.Value == nil || share.Index == 0 {
+		return false
+	}
+
+	g := share.Value.Group()
+	powers := make([]*ecc.Scalar, len(commitments))
+	x := g.NewScalar().SetUInt64(share.Index)
+	power := g.NewScalar().One()
+
+	for i, c := range commitments {
+		if c == nil || c.Group() != g {
+			return false
+		}
+
+		powers[i] = power.Copy()
+		power.Multiply(x)
+	}
+
+	return g.ScalarBaseMult(share.Value).Equal(g.MultiScalarMult(powers, commitments))
+}
+
+// evaluate returns the polynomial with the coefficients in ascending degree evaluated at x, using Horner's method.
+func evaluate(coefficients []*ecc.Scalar, x *ecc.Scalar) *ecc.Scalar {
+	y := coefficients[len(coefficients)-1].Copy()
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		y.Multiply(x).Add(coefficients[i])
+	}
+
+	return y
+}
+
+// Recover returns the secret the shares were split from, by Lagrange interpolation of the polynomial at zero. Given
+// fewer shares than the threshold, the result is unrelated to the secret. It panics if there are no shares, if a
+// share value is nil or of another group than the first, or if an index is zero or duplicated.
+func Recover(shares []Share) *ecc.Scalar {
+	if len(shares) == 0 {
+		panic(errNoShares)
+	}
+
+	if shares[0].Value == nil {
+		panic(internal.ErrParamNilScalar)
+	}
+
+	g := shares[0].Value.Group()
+	indexes := make(map[uint64]struct{}, len(shares))
+
+	for _, share := range shares {
+		switch {
+		case share.Value == nil:
+			panic(internal.ErrParamNilScalar)
+		case share.Value.Group() != g:
+			panic(internal.ErrCastScalar)
+		case share.Index == 0:
+			panic(errZeroIndex)
+		}
+
+		if _, ok := indexes[share.Index]; ok {
+			panic(errDuplicateIndex)
+		}
+
+		indexes[share.Index] = struct{}{}
+	}
+
+	secret := g.NewScalar()
+
+	for i, share := range shares {
+		// The Lagrange coefficient at zero is the product of x_j / (x_j - x_i) for j != i.
+		numerator := g.NewScalar().One()
+		denominator := g.NewScalar().One()
+		xi := g.NewScalar().SetUInt64(share.Index)
+
+		for j, other := range shares {
+			if i == j {
+				continue
+			}
+
+			xj := g.NewScalar().SetUInt64(other.Index)
+			numerator.Multiply(xj)
+			denominator.Multiply(xj.Subtract(xi))
+		}
+
+		secret.Add(numerator.Multiply(denominator.Invert()).Multiply(share.Value))
+	}
+
+	return secret
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/bytemare/hash"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+const (
+	// maxDSTLength is the longest DST expand_message uses as is, longer ones being hashed as in RFC9380 section 5.3.3.
+	maxDSTLength = 255
+
+	// oversizeDSTPrefix is the prefix of the hashed DSTs longer than maxDSTLength.
+	oversizeDSTPrefix = "H2C-OVERSIZE-DST-"
+)
+
+// expandXMDReader returns the output of expand_message_xmd with the hash function id, on the input read from r until
+// EOF and the DST. The input only goes into the computation of b_0, so it is streamed into the hash function and never
+// held in memory.
+func expandXMDReader(id crypto.Hash, r io.Reader, dst []byte, length uint) ([]byte, error) {
+	h := id.New()
+
+	if len(dst) > maxDSTLength {
+		_, _ = h.Write([]byte(oversizeDSTPrefix))
+		_, _ = h.Write(dst)
+		dst = h.Sum(nil)
+
+		h.Reset()
+	}
+
+	dstPrime := append(slices.Clip(dst), byte(len(dst)))
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	_, _ = h.Write(make([]byte, h.BlockSize()))
+
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+
+	_, _ = h.Write([]byte{byte(length >> 8), byte(length), 0})
+	_, _ = h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime), with b_0 xor'ed with zeros for b_1.
+	ell := (length + uint(len(b0)) - 1) / uint(len(b0))
+	uniform := make([]byte, 0, ell*uint(len(b0)))
+	bi := make([]byte, len(b0))
+
+	for i := uint(1); i <= ell; i++ {
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+
+		h.Reset()
+		_, _ = h.Write(bi)
+		_, _ = h.Write([]byte{byte(i)})
+		_, _ = h.Write(dstPrime)
+		bi = h.Sum(bi[:0])
+		uniform = append(uniform, bi...)
+	}
+
+	return uniform[:length], nil
+}
+
+// expandXOFReader returns the output of expand_message_xof with SHAKE256, on the input read from r until EOF and the
+// DST. The input is streamed into the XOF and never held in memory.
+func expandXOFReader(r io.Reader, dst []byte, length uint) ([]byte, error) {
+	if len(dst) > maxDSTLength {
+		// Shorten the DST as the hash2curve package does for the buffered expansion, to ceil(2 * k / 8) bytes.
+		x := hash.SHAKE256.GetXOF()
+		x.SetOutputSize(int(math.Ceil(float64(2*x.Algorithm().SecurityLevel()) / 8)))
+		dst = x.Hash([]byte(oversizeDSTPrefix), dst)
+	}
+
+	// msg_prime = msg || I2OSP(len_in_bytes, 2) || DST || I2OSP(len(DST), 1)
+	xof := sha3.NewShake256()
+
+	if _, err := io.Copy(xof, r); err != nil {
+		return nil, err
+	}
+
+	_, _ = xof.Write([]byte{byte(length >> 8), byte(length)})
+	_, _ = xof.Write(dst)
+	_, _ = xof.Write([]byte{byte(len(dst))})
+
+	uniform := make([]byte, length)
+	_, _ = xof.Read(uniform)
+
+	return uniform, nil
+}
+
+// expandReader returns length bytes of the expand_message function of the group's hash-to-curve suite, on the input
+// read from r until EOF and the DST.
+func (g Group) expandReader(r io.Reader, dst []byte, length uint) ([]byte, error) {
+	switch g {
+	case Decaf448Shake256, Edwards448Shake256:
+		return expandXOFReader(r, dst, length)
+	default:
+		return expandXMDReader(g.HashFunc(), r, dst, length)
+	}
+}
+
+// HashToGroupReader returns the same Element as HashToGroup on the input read from r until EOF, streaming it into the
+// hash function of the group's expand_message instead of holding it in memory, e.g. to hash large files. Secp256k1,
+// whose hash-to-curve is implemented by an external package, reads the whole input first. An error is returned if
+// reading from r fails. The DST must not be empty or nil, and is recommended to be longer than 16 bytes.
+func (g Group) HashToGroupReader(r io.Reader, dst []byte) (*Element, error) {
+	checkDST(dst)
+
+	u, ok := g.get().(internal.UniformHasher)
+	if !ok {
+		input, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("HashToGroupReader: %w", err)
+		}
+
+		return g.HashToGroup(input, dst), nil
+	}
+
+	length, _ := u.UniformLengths()
+
+	uniform, err := g.expandReader(r, dst, length)
+	if err != nil {
+		return nil, fmt.Errorf("HashToGroupReader: %w", err)
+	}
+
+	return newPoint(u.ElementFromUniform(uniform)), nil
+}
+
+// HashToScalarReader returns the same Scalar as HashToScalar on the input read from r until EOF, streaming it as
+// HashToGroupReader does. An error is returned if reading from r fails. The DST must not be empty or nil, and is
+// recommended to be longer than 16 bytes.
+func (g Group) HashToScalarReader(r io.Reader, dst []byte) (*Scalar, error) {
+	checkDST(dst)
+
+	u, ok := g.get().(internal.UniformHasher)
+	if !ok {
+		input, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("HashToScalarReader: %w", err)
+		}
+
+		return g.HashToScalar(input, dst), nil
+	}
+
+	_, length := u.UniformLengths()
+
+	uniform, err := g.expandReader(r, dst, length)
+	if err != nil {
+		return nil, fmt.Errorf("HashToScalarReader: %w", err)
+	}
+
+	return newScalar(u.ScalarFromUniform(uniform)), nil
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestAdditiveSharing(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, n := range []int{1, 2, 5} {
+			secret := g.NewScalar().Random()
+			secretCopy := secret.Copy()
+			shares := g.AdditiveSplit(secret, n)
+
+			if len(shares) != n {
+				t.Fatalf("expected %d shares, got %d", n, len(shares))
+			}
+
+			if !ecc.AdditiveCombine(shares).Equal(secret) {
+				t.Fatalf("expected the %d shares to combine to the secret", n)
+			}
+
+			if !secret.Equal(secretCopy) {
+				t.Fatal("unexpected modification of the secret")
+			}
+
+			// Missing a share yields another scalar.
+			if n > 1 && ecc.AdditiveCombine(shares[1:]).Equal(secret) {
+				t.Fatal("expected all shares to be needed")
+			}
+		}
+
+		if err := testPanic("nil secret", internal.ErrParamNilScalar, func() {
+			_ = g.AdditiveSplit(nil, 2)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("zero count", errNonPositiveCount, func() {
+			_ = g.AdditiveSplit(g.NewScalar().Random(), 0)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("no shares", errNoShares, func() {
+			_ = ecc.AdditiveCombine(nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil share", internal.ErrParamNilScalar, func() {
+			_ = ecc.AdditiveCombine([]*ecc.Scalar{g.NewScalar().Random(), nil})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		var wrongGroup ecc.Group = ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			_ = g.AdditiveSplit(wrongGroup.NewScalar().Random(), 2)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -10,7 +10,10 @@ package ecc_test
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
+
+	"github.com/0xBridge/ecc"
 )
 
 func benchAll(b *testing.B, f func(*testing.B, *testGroup)) {
@@ -47,6 +50,35 @@ func BenchmarkHashToGroup(b *testing.B) {
 	})
 }
 
+func BenchmarkBatchHashToGroup(b *testing.B) {
+	dst := []byte("benchmark batch hash to group")
+	inputs := make([][]byte, 64)
+
+	for i := range inputs {
+		inputs[i] = []byte(fmt.Sprintf("input %d", i))
+	}
+
+	for _, g := range []ecc.Group{ecc.P224Sha256, ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512} {
+		b.Run(g.String(), func(b *testing.B) {
+			b.Run("Batch", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_ = g.BatchHashToGroup(inputs, dst)
+				}
+			})
+
+			b.Run("Loop", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					for _, input := range inputs {
+						_ = g.HashToGroup(input, dst)
+					}
+				}
+			})
+		})
+	}
+}
+
 func BenchmarkSubtraction(b *testing.B) {
 	benchAll(b, func(b *testing.B, group *testGroup) {
 		b.ResetTimer()
@@ -58,7 +90,48 @@ func BenchmarkSubtraction(b *testing.B) {
 	})
 }
 
-func BenchmarkScalarBaseMult(b *testing.B) {
+func BenchmarkScalarArithmetic(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s, t := group.group.NewScalar().Random(), group.group.NewScalar().Random()
+
+		b.Run("Add", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s.Add(t)
+			}
+		})
+
+		b.Run("Subtract", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s.Subtract(t)
+			}
+		})
+
+		b.Run("Multiply", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s.Multiply(t)
+			}
+		})
+
+		b.Run("MultiplyAdd", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s.MultiplyAdd(t, t)
+			}
+		})
+
+		b.Run("Invert", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				s.Invert()
+			}
+		})
+	})
+}
+
+func BenchmarkBaseMultiply(b *testing.B) {
 	benchAll(b, func(b *testing.B, group *testGroup) {
 		priv := group.group.NewScalar().Random()
 		b.ResetTimer()
@@ -70,6 +143,46 @@ func BenchmarkScalarBaseMult(b *testing.B) {
 	})
 }
 
+func BenchmarkScalarBaseMult(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		priv := group.group.NewScalar().Random()
+		_ = group.group.ScalarBaseMult(priv) // builds the tables, if any
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = group.group.ScalarBaseMult(priv)
+		}
+	})
+}
+
+func BenchmarkBaseMultAll(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		scalars := make([]*ecc.Scalar, 1024)
+		for i := range scalars {
+			scalars[i] = group.group.NewScalar().Random()
+		}
+
+		// The products are encoded, as the batch normalization of BaseMultAll saves the inversions of the encodings.
+		b.Run("Batch", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, e := range group.group.BaseMultAll(scalars) {
+					_ = e.Encode()
+				}
+			}
+		})
+
+		b.Run("Loop", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, s := range scalars {
+					_ = group.group.ScalarBaseMult(s).Encode()
+				}
+			}
+		})
+	})
+}
+
 func BenchmarkScalarMult(b *testing.B) {
 	benchAll(b, func(b *testing.B, group *testGroup) {
 		priv := group.group.NewScalar().Random()
@@ -82,6 +195,85 @@ func BenchmarkScalarMult(b *testing.B) {
 	})
 }
 
+func BenchmarkMultiplyVartime(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s := group.group.NewScalar().Random()
+		e := randomElement(group.group)
+
+		b.Run("constant-time", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = e.Copy().Multiply(s)
+			}
+		})
+
+		b.Run("vartime", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = e.Copy().MultiplyVartime(s)
+			}
+		})
+	})
+}
+
+func BenchmarkPrecomputedElement(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		s := group.group.NewScalar().Random()
+		e := randomElement(group.group)
+		p := e.Precompute()
+
+		b.Run("Precompute", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = e.Precompute()
+			}
+		})
+
+		b.Run("Multiply", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = e.Copy().Multiply(s)
+			}
+		})
+
+		b.Run("PrecomputedMultiply", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = p.Multiply(s)
+			}
+		})
+	})
+}
+
+func BenchmarkBaseTable(b *testing.B) {
+	groups := []ecc.Group{
+		ecc.P224Sha256, ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512, ecc.Secp256k1Sha256,
+		ecc.BLS12381G1Sha256, ecc.PallasSha256, ecc.VestaSha256,
+	}
+
+	for _, g := range groups {
+		b.Run(g.String(), func(b *testing.B) {
+			s := g.NewScalar().Random()
+			e := g.Base().Double()
+			_ = g.BaseTable()
+
+			b.Run("Multiply", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_ = e.Copy().Multiply(s)
+				}
+			})
+
+			b.Run("BaseMultiply", func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					_ = g.Base().Multiply(s)
+				}
+			})
+		})
+	}
+}
+
 func BenchmarkMarshalUnmarshal(b *testing.B) {
 	benchAll(b, func(b *testing.B, group *testGroup) {
 		pub := group.group.Base().Multiply(group.group.NewScalar().Random())
@@ -98,3 +290,171 @@ func BenchmarkMarshalUnmarshal(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkMultiScalarMult(b *testing.B) {
+	// The naive sub-benchmarks sum the separate multiplications, against which Pippenger's method wins for large n.
+	for _, n := range []int{16, 256, 4096} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			benchAll(b, func(b *testing.B, group *testGroup) {
+				g := group.group
+				scalars := make([]*ecc.Scalar, n)
+				elements := make([]*ecc.Element, n)
+
+				for i := range n {
+					scalars[i] = g.NewScalar().Random()
+					elements[i] = randomElement(g)
+				}
+
+				b.Run("msm", func(b *testing.B) {
+					b.ReportAllocs()
+
+					for i := 0; i < b.N; i++ {
+						_ = g.MultiScalarMult(scalars, elements)
+					}
+				})
+
+				b.Run("naive", func(b *testing.B) {
+					b.ReportAllocs()
+
+					for i := 0; i < b.N; i++ {
+						_ = naiveMultiScalarMult(g, scalars, elements)
+					}
+				})
+			})
+		})
+	}
+}
+
+func BenchmarkMultiScalarMultParallel(b *testing.B) {
+	n := 4096
+
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		g := group.group
+		scalars := make([]*ecc.Scalar, n)
+		elements := make([]*ecc.Element, n)
+
+		for i := range n {
+			scalars[i] = g.NewScalar().Random()
+			elements[i] = randomElement(g)
+		}
+
+		b.Run("serial", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = g.MultiScalarMult(scalars, elements)
+			}
+		})
+
+		b.Run("parallel", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = g.MultiScalarMultParallel(scalars, elements, 0)
+			}
+		})
+	})
+}
+
+// BenchmarkPool compares the allocations of a loop computing the temporary values of a signature, with new values and
+// with pooled ones.
+func BenchmarkPool(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		g := group.group
+		x, c, k := g.NewScalar().Random(), g.NewScalar().Random(), g.NewScalar().Random()
+		p, q := randomElement(g), randomElement(g)
+
+		b.Run("new", func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				_ = g.NewScalar().Set(x).MultiplyAdd(c, k)
+				_ = g.NewElement().Set(p).Add(q)
+			}
+		})
+
+		b.Run("pool", func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				s := g.GetScalar().Set(x).MultiplyAdd(c, k)
+				e := g.GetElement().Set(p).Add(q)
+
+				g.PutScalar(s)
+				g.PutElement(e)
+			}
+		})
+	})
+}
+
+func BenchmarkDoubleScalarBaseMult(b *testing.B) {
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		g := group.group
+		s1, s2 := g.NewScalar().Random(), g.NewScalar().Random()
+		e1, e2 := randomElement(g), randomElement(g)
+
+		b.Run("separate", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = e1.Copy().Multiply(s1).Add(e2.Copy().Multiply(s2))
+			}
+		})
+
+		b.Run("fused", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = g.DoubleScalarBaseMult(s1, e1, s2, e2)
+			}
+		})
+	})
+}
+
+func BenchmarkAppendEncoding(b *testing.B) {
+	const count = 1000
+
+	benchAll(b, func(b *testing.B, group *testGroup) {
+		g := group.group
+		scalars := make([]*ecc.Scalar, count)
+		for i := range scalars {
+			scalars[i] = g.RandomScalar()
+		}
+
+		buf := make([]byte, 0, 2*count*g.ScalarLength())
+
+		b.Run("MarshalBinary", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				out := buf[:0]
+				for _, s := range scalars {
+					enc, _ := s.MarshalBinary()
+					out = append(out, enc...)
+				}
+			}
+		})
+
+		b.Run("AppendBinary", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				out := buf[:0]
+				for _, s := range scalars {
+					out, _ = s.AppendBinary(out)
+				}
+			}
+		})
+
+		b.Run("MarshalText", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				out := buf[:0]
+				for _, s := range scalars {
+					enc, _ := s.MarshalText()
+					out = append(out, enc...)
+				}
+			}
+		})
+
+		b.Run("AppendText", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				out := buf[:0]
+				for _, s := range scalars {
+					out, _ = s.AppendText(out)
+				}
+			}
+		})
+	})
+}
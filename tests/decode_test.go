@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/debug"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestDecodeElements(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		elements, err := g.DecodeElements(nil)
+		if err != nil || len(elements) != 0 {
+			t.Fatal("expected empty output on empty input")
+		}
+
+		expected := make([]*ecc.Element, 10)
+		data := make([][]byte, len(expected))
+
+		for i := range expected {
+			expected[i] = randomElement(g)
+			data[i] = expected[i].Encode()
+		}
+
+		elements, err = g.DecodeElements(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(elements) != len(expected) {
+			t.Fatalf("expected %d elements, got %d", len(expected), len(elements))
+		}
+
+		for i, e := range elements {
+			if !e.Equal(expected[i]) {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		// The first invalid encoding is reported.
+		data[7] = g.NewElement().Encode()
+		data[4] = data[4][:len(data[4])-1]
+
+		elements, err = g.DecodeElements(data)
+		if err == nil || elements != nil {
+			t.Fatal("expected error")
+		}
+
+		if !strings.HasPrefix(err.Error(), "DecodeElements: element 4: ") {
+			t.Fatalf("unexpected error %q", err)
+		}
+
+		if _, err = g.DecodeElements([][]byte{data[0], nil}); err == nil ||
+			!strings.HasPrefix(err.Error(), "DecodeElements: element 1: ") {
+			t.Fatalf("unexpected error %q", err)
+		}
+	})
+}
+
+func TestEncodeElements(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		blob := g.EncodeElements(nil)
+		if len(blob) != 4 {
+			t.Fatalf("unexpected empty encoding %x", blob)
+		}
+
+		elements, err := g.DecodeElementsBlob(blob)
+		if err != nil || len(elements) != 0 {
+			t.Fatal("expected empty output on empty input")
+		}
+
+		expected := make([]*ecc.Element, 5)
+		for i := range expected {
+			expected[i] = randomElement(g)
+		}
+
+		blob = g.EncodeElements(expected)
+		if len(blob) != 4+len(expected)*g.ElementLength() {
+			t.Fatalf("unexpected encoding length %d", len(blob))
+		}
+
+		elements, err = g.DecodeElementsBlob(blob)
+		if err != nil || len(elements) != len(expected) {
+			t.Fatalf("unexpected decoding: %v", err)
+		}
+
+		for i, e := range elements {
+			if !e.Equal(expected[i]) {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		// The blob length must match the declared count.
+		for _, bad := range [][]byte{nil, blob[:3], blob[:len(blob)-1], append(blob, 0)} {
+			if _, err = g.DecodeElementsBlob(bad); !errors.Is(err, ecc.ErrVectorLength) {
+				t.Fatalf("expected ErrVectorLength, got %v", err)
+			}
+		}
+
+		bad := append([]byte{}, blob...)
+		bad[3] = 4
+		if _, err = g.DecodeElementsBlob(bad); !errors.Is(err, ecc.ErrVectorLength) {
+			t.Fatalf("expected ErrVectorLength, got %v", err)
+		}
+
+		bad = append([]byte{0xff, 0xff, 0xff, 0xff}, blob[4:]...)
+		if _, err = g.DecodeElementsBlob(bad); !errors.Is(err, ecc.ErrVectorLength) {
+			t.Fatalf("expected ErrVectorLength, got %v", err)
+		}
+
+		// The first invalid encoding is reported.
+		bad = append([]byte{}, blob...)
+		copy(bad[4+2*g.ElementLength():], g.NewElement().Encode())
+
+		if _, err = g.DecodeElementsBlob(bad); !errors.Is(err, ecc.ErrInvalidPointEncoding) ||
+			!strings.HasPrefix(err.Error(), "DecodeElementsBlob: element 2: ") {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		if err := testPanic("nil element", internal.ErrParamNilPoint, func() {
+			_ = g.EncodeElements([]*ecc.Element{expected[0], nil})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestDecode_FailureLeavesReceiverUnchanged(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.RandomScalar()
+		reference := s.Copy()
+
+		for name, decode := range map[string]func() error{
+			"Decode short":    func() error { return s.Decode([]byte{1, 2}) },
+			"Decode empty":    func() error { return s.Decode(nil) },
+			"Decode high":     func() error { return s.Decode(debug.BadScalarHigh(g)) },
+			"Decode long":     func() error { return s.Decode(append(reference.Encode(), 0)) },
+			"DecodeHex":       func() error { return s.DecodeHex(hex.EncodeToString(debug.BadScalarHigh(g))) },
+			"DecodeHex char":  func() error { return s.DecodeHex("zz") },
+			"DecodeBase64":    func() error { return s.DecodeBase64("AAE") },
+			"UnmarshalBinary": func() error { return s.UnmarshalBinary(debug.BadScalarHigh(g)) },
+			"UnmarshalText":   func() error { return s.UnmarshalText([]byte("00")) },
+			"UnmarshalJSON":   func() error { return s.UnmarshalJSON([]byte(`"00"`)) },
+			"SetString":       func() error { return s.SetString("-1", 10) },
+		} {
+			if err := decode(); err == nil {
+				t.Fatalf("scalar %s: expected error", name)
+			}
+
+			if !s.Equal(reference) {
+				t.Fatalf("scalar %s: expected the receiver to be unchanged", name)
+			}
+		}
+
+		e := g.RandomElement()
+		referenceElement := e.Copy()
+
+		for name, decode := range map[string]func() error{
+			"Decode short":        func() error { return e.Decode([]byte{1, 2}) },
+			"Decode empty":        func() error { return e.Decode(nil) },
+			"Decode bad":          func() error { return e.Decode(debug.BadElementEncoding(g)) },
+			"Decode off curve":    func() error { return e.Decode(debug.BadElementOffCurve(g)) },
+			"Decode identity":     func() error { return e.Decode(g.NewElement().Encode()) },
+			"Decode long":         func() error { return e.Decode(append(referenceElement.Encode(), 0)) },
+			"DecodeAllowIdentity": func() error { return e.DecodeAllowIdentity(debug.BadElementOffCurve(g)) },
+			"DecodeHex":           func() error { return e.DecodeHex(hex.EncodeToString(debug.BadElementOffCurve(g))) },
+			"DecodeHex char":      func() error { return e.DecodeHex("zz") },
+			"DecodeBase64":        func() error { return e.DecodeBase64("AAE") },
+			"UnmarshalBinary":     func() error { return e.UnmarshalBinary(debug.BadElementEncoding(g)) },
+			"UnmarshalText":       func() error { return e.UnmarshalText([]byte("00")) },
+			"UnmarshalJSON":       func() error { return e.UnmarshalJSON([]byte(`"00"`)) },
+		} {
+			if err := decode(); err == nil {
+				t.Fatalf("element %s: expected error", name)
+			}
+
+			if !e.Equal(referenceElement) {
+				t.Fatalf("element %s: expected the receiver to be unchanged", name)
+			}
+		}
+	})
+}
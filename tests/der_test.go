@@ -0,0 +1,298 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+)
+
+func hasDER(g ecc.Group) bool {
+	switch g {
+	case ecc.Ristretto255Sha512, ecc.Decaf448Shake256, ecc.BLS12381G1Sha256, ecc.PallasSha256, ecc.VestaSha256:
+		return false
+	default:
+		return true
+	}
+}
+
+// hasPrivateKeyDER returns whether scalars of the group have a DER encoding, i.e. for the groups with SEC1 encodings.
+func hasPrivateKeyDER(g ecc.Group) bool {
+	return hasDER(g) && g != ecc.Edwards25519Sha512 && g != ecc.Edwards448Shake256
+}
+
+func TestElement_DER(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		e := group.group.Base().Multiply(group.group.NewScalar().Random())
+
+		encoded, err := e.MarshalDER()
+		if !hasDER(group.group) {
+			if !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			if _, err = ecc.DecodeElementDER(group.group, encoded); !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			return
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := ecc.DecodeElementDER(group.group, encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if _, err = group.group.NewElement().MarshalDER(); err == nil {
+			t.Fatal("expected error on identity")
+		}
+
+		if _, err = ecc.DecodeElementDER(group.group, encoded[:len(encoded)-1]); err == nil {
+			t.Fatal("expected error on truncated encoding")
+		}
+
+		if _, err = ecc.DecodeElementDER(group.group, append(encoded, 0)); err == nil {
+			t.Fatal("expected error on trailing data")
+		}
+
+		// A key of another group must be rejected.
+		other := ecc.P256Sha256
+		if group.group == ecc.P256Sha256 {
+			other = ecc.P384Sha384
+		}
+
+		if _, err = ecc.DecodeElementDER(other, encoded); !errors.Is(err, ecc.ErrDERAlgorithm) {
+			t.Fatalf("expected algorithm error, got %v", err)
+		}
+	})
+}
+
+func TestElement_DER_X509(t *testing.T) {
+	curves := map[ecc.Group]elliptic.Curve{
+		ecc.P224Sha256: elliptic.P224(),
+		ecc.P256Sha256: elliptic.P256(),
+		ecc.P384Sha384: elliptic.P384(),
+		ecc.P521Sha512: elliptic.P521(),
+	}
+
+	for g, curve := range curves {
+		e := g.Base().Multiply(g.NewScalar().Random())
+
+		x, y, err := e.AffineCoordinates()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected, err := x509.MarshalPKIXPublicKey(&ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoded, err := e.MarshalDER()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(encoded, expected) {
+			t.Fatalf("%s: unexpected DER encoding", g)
+		}
+
+		if _, err = x509.ParsePKIXPublicKey(encoded); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Ed25519 public keys are edwards25519 elements.
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := ecc.DecodeElementDER(ecc.Edwards25519Sha512, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(e.Encode(), pub) {
+		t.Fatal(errExpectedEquality)
+	}
+
+	encoded, err := e.MarshalDER()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(encoded, expected) {
+		t.Fatal("unexpected Ed25519 DER encoding")
+	}
+}
+
+func ecdsaKey(t *testing.T, g ecc.Group, curve elliptic.Curve) (*ecc.Scalar, *ecdsa.PrivateKey) {
+	s := g.NewScalar().Random()
+
+	x, y, err := g.Base().Multiply(s).AffineCoordinates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s, &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)},
+		D:         new(big.Int).SetBytes(s.Encode()),
+	}
+}
+
+func TestScalar_DER(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		s := group.group.NewScalar().Random()
+
+		encoded, err := s.MarshalDER()
+		if !hasPrivateKeyDER(group.group) {
+			if !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			if _, err = ecc.DecodeScalarDER(group.group, encoded); !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			return
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := ecc.DecodeScalarDER(group.group, encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(s) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if _, err = group.group.NewScalar().MarshalDER(); err == nil {
+			t.Fatal("expected error on zero scalar")
+		}
+
+		if _, err = ecc.DecodeScalarDER(group.group, append(encoded, 0)); err == nil {
+			t.Fatal("expected error on trailing data")
+		}
+
+		other := ecc.P256Sha256
+		if group.group == ecc.P256Sha256 {
+			other = ecc.P384Sha384
+		}
+
+		if _, err = ecc.DecodeScalarDER(other, encoded); !errors.Is(err, ecc.ErrDERAlgorithm) {
+			t.Fatalf("expected algorithm error, got %v", err)
+		}
+	})
+}
+
+func TestScalar_DER_X509(t *testing.T) {
+	curves := map[ecc.Group]elliptic.Curve{
+		ecc.P224Sha256: elliptic.P224(),
+		ecc.P256Sha256: elliptic.P256(),
+		ecc.P384Sha384: elliptic.P384(),
+		ecc.P521Sha512: elliptic.P521(),
+	}
+
+	for g, curve := range curves {
+		s, key := ecdsaKey(t, g, curve)
+
+		expected, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoded, err := s.MarshalDER()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(encoded, expected) {
+			t.Fatalf("%s: unexpected DER encoding", g)
+		}
+
+		if _, err = x509.ParsePKCS8PrivateKey(encoded); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A PKCS #8 Ed25519 key must be rejected as an algorithm mismatch.
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoded, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = ecc.DecodeScalarDER(ecc.P256Sha256, encoded); !errors.Is(err, ecc.ErrDERAlgorithm) {
+		t.Fatalf("expected algorithm error, got %v", err)
+	}
+}
+
+func TestDecodeElementDER_Compressed(t *testing.T) {
+	for _, g := range []ecc.Group{ecc.P256Sha256, ecc.Secp256k1Sha256} {
+		e := g.Base().Multiply(g.NewScalar().Random())
+
+		encoded, err := e.MarshalDER()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Swap the uncompressed key at the end of the structure for the compressed one, and fix the lengths.
+		uncompressed, _ := e.EncodeUncompressed()
+		compressed := e.Encode()
+		prefix := encoded[:len(encoded)-len(uncompressed)]
+		shrink := byte(len(uncompressed) - len(compressed))
+
+		spki := append(bytes.Clone(prefix), compressed...)
+		spki[1] -= shrink
+		spki[len(prefix)-2] -= shrink
+
+		decoded, err := ecc.DecodeElementDER(g, spki)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+}
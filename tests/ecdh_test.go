@@ -0,0 +1,342 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+var ecdhGroups = map[ecc.Group]ecdh.Curve{
+	ecc.P256Sha256:         ecdh.P256(),
+	ecc.P384Sha384:         ecdh.P384(),
+	ecc.P521Sha512:         ecdh.P521(),
+	ecc.Edwards25519Sha512: ecdh.X25519(),
+}
+
+// ecdhSecret returns the crypto/ecdh shared secret of s and e, i.e. the encoded x or u coordinate of their product.
+func ecdhSecret(t *testing.T, s *ecc.Scalar, e *ecc.Element) []byte {
+	p := e.Copy().Multiply(s)
+	if s.Group() == ecc.Edwards25519Sha512 {
+		return p.XCoordinate()
+	}
+
+	x, _, err := p.AffineCoordinates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return x
+}
+
+func TestECDH_Unsupported(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if _, ok := ecdhGroups[group.group]; ok {
+			return
+		}
+
+		if _, err := group.group.Base().ToECDH(); !errors.Is(err, errors.ErrUnsupported) {
+			t.Fatalf("expected unsupported error, got %v", err)
+		}
+
+		if _, err := group.group.NewScalar().Random().ToECDHPrivate(); !errors.Is(err, errors.ErrUnsupported) {
+			t.Fatalf("expected unsupported error, got %v", err)
+		}
+	})
+}
+
+func TestECDH_Interop(t *testing.T) {
+	for g, curve := range ecdhGroups {
+		// Keys from this package used with crypto/ecdh.
+		s := g.NewScalar().Random()
+
+		priv, err := s.ToECDHPrivate()
+		for g == ecc.Edwards25519Sha512 && err != nil {
+			s = g.NewScalar().Random()
+			priv, err = s.ToECDHPrivate()
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peer, err := curve.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pub, err := g.Base().Multiply(s).ToECDH()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !pub.Equal(priv.PublicKey()) {
+			t.Fatalf("%s: unexpected public key", g)
+		}
+
+		secret, err := priv.ECDH(peer.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peerElement, err := ecc.ElementFromECDH(peer.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(secret, ecdhSecret(t, s, peerElement)) {
+			t.Fatalf("%s: unexpected shared secret", g)
+		}
+
+		// Keys from crypto/ecdh used with this package.
+		peerScalar, err := ecc.ScalarFromECDH(peer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		element, err := ecc.ElementFromECDH(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(secret, ecdhSecret(t, peerScalar, element)) {
+			t.Fatalf("%s: unexpected shared secret", g)
+		}
+
+		if _, err = g.NewElement().ToECDH(); err == nil {
+			t.Fatal("expected error on identity")
+		}
+
+		if _, err = g.NewScalar().ToECDHPrivate(); err == nil {
+			t.Fatal("expected error on zero scalar")
+		}
+	}
+}
+
+func TestECDH_RoundTrip(t *testing.T) {
+	for _, g := range []ecc.Group{ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512} {
+		s := g.NewScalar().Random()
+		e := g.Base().Multiply(s)
+
+		priv, err := s.ToECDHPrivate()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decodedScalar, err := ecc.ScalarFromECDH(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pub, err := e.ToECDH()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decodedElement, err := ecc.ElementFromECDH(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decodedScalar.Equal(s) || !decodedElement.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+
+	// X25519 keys only determine Edwards25519 elements up to their sign.
+	g := ecc.Edwards25519Sha512
+	e := g.Base().Multiply(g.NewScalar().Random())
+
+	pub, err := e.ToECDH()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := ecc.ElementFromECDH(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !decoded.Equal(e) && !decoded.Equal(e.Copy().Negate()) {
+		t.Fatal(errExpectedEquality)
+	}
+
+	// Scalars without a clamped representation are rejected, and those with one round-trip.
+	failures := 0
+
+	for range 64 {
+		s := g.NewScalar().Random()
+
+		priv, err := s.ToECDHPrivate()
+		if err != nil {
+			failures++
+			continue
+		}
+
+		decodedScalar, err := ecc.ScalarFromECDH(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decodedScalar.Equal(s) {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+
+	if failures == 0 || failures == 64 {
+		t.Fatalf("unexpected number of scalars without X25519 representation: %d", failures)
+	}
+}
+
+func TestECDH(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+
+		ab, err := ecc.ECDH(a, g.Base().Multiply(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ba, err := ecc.ECDH(b, g.Base().Multiply(a))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !ab.Equal(ba) || !ab.Equal(g.Base().Multiply(a.Multiply(b)).ClearCofactor()) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestECDH_Fails(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+
+		if _, err := ecc.ECDH(nil, g.Base()); !errors.Is(err, internal.ErrParamNilScalar) {
+			t.Fatalf("expected nil scalar error, got %v", err)
+		}
+
+		if _, err := ecc.ECDH(g.NewScalar(), g.Base()); !errors.Is(err, internal.ErrParamNilScalar) {
+			t.Fatalf("expected nil scalar error, got %v", err)
+		}
+
+		if _, err := ecc.ECDH(sk, nil); !errors.Is(err, internal.ErrParamNilPoint) {
+			t.Fatalf("expected nil point error, got %v", err)
+		}
+
+		if _, err := ecc.ECDH(sk, g.NewElement()); !errors.Is(err, internal.ErrIdentity) {
+			t.Fatalf("expected identity error, got %v", err)
+		}
+
+		other := ecc.Ristretto255Sha512
+		if g == other {
+			other = ecc.P256Sha256
+		}
+
+		if _, err := ecc.ECDH(sk, other.Base()); !errors.Is(err, internal.ErrCastElement) {
+			t.Fatalf("expected cast error, got %v", err)
+		}
+	})
+
+	// Small-order and mixed-order points are rejected.
+	g := ecc.Edwards25519Sha512
+	small := decodeElement(t, g, "ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+
+	for _, peer := range []*ecc.Element{small, g.Base().Add(small)} {
+		if _, err := ecc.ECDH(g.NewScalar().Random(), peer); !errors.Is(err, internal.ErrPointNotInSubgroup) {
+			t.Fatalf("expected subgroup error, got %v", err)
+		}
+	}
+}
+
+func TestECDH_Vectors(t *testing.T) {
+	// RFC 7748 section 6.1: Alice's private key and Bob's public key, and their X25519 shared secret.
+	alicePrivate, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bobPublic, _ := hex.DecodeString("de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f")
+	shared := "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742"
+
+	priv, err := ecdh.X25519().NewPrivateKey(alicePrivate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pub, err := ecdh.X25519().NewPublicKey(bobPublic)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sk, err := ecc.ScalarFromECDH(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	peer, err := ecc.ElementFromECDH(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ECDH multiplies by the cofactor 8, which the clamped X25519 private key already is a multiple of.
+	g := ecc.Edwards25519Sha512
+	sk.Multiply(g.NewScalar().SetUInt64(8).Invert())
+
+	e, err := ecc.ECDH(sk, peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(e.XCoordinate()) != shared {
+		t.Fatalf("unexpected shared secret %x", e.XCoordinate())
+	}
+
+	// The NIST groups match crypto/ecdh.
+	for _, g := range []ecc.Group{ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512} {
+		priv, err = ecdhGroups[g].GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peerPriv, err := ecdhGroups[g].GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		secret, err := priv.ECDH(peerPriv.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sk, err = ecc.ScalarFromECDH(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		peer, err = ecc.ElementFromECDH(peerPriv.PublicKey())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		e, err = ecc.ECDH(sk, peer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(secret, ecdhSecret(t, g.NewScalar().One(), e)) {
+			t.Fatalf("%s: unexpected shared secret", g)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	eccdsa "github.com/0xBridge/ecc/ecdsa"
+)
+
+var ecdsaGroups = []ecc.Group{
+	ecc.P224Sha256, ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512, ecc.Secp256k1Sha256,
+}
+
+func TestElement_ToECDSAPublicKey(t *testing.T) {
+	curves := map[ecc.Group]elliptic.Curve{
+		ecc.P224Sha256: elliptic.P224(),
+		ecc.P256Sha256: elliptic.P256(),
+		ecc.P384Sha384: elliptic.P384(),
+		ecc.P521Sha512: elliptic.P521(),
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.NewScalar().Random()
+		e := g.Base().Multiply(s)
+
+		curve, ok := curves[g]
+		if !ok {
+			if _, err := e.ToECDSAPublicKey(); !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			return
+		}
+
+		pub, err := e.ToECDSAPublicKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if pub.Curve != curve {
+			t.Fatal("unexpected curve")
+		}
+
+		// A signature with the scalar must verify with the converted public key.
+		digest := sha256.Sum256([]byte("message"))
+		priv := &ecdsa.PrivateKey{PublicKey: *pub, D: new(big.Int).SetBytes(s.Encode())}
+
+		sig, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !ecdsa.VerifyASN1(pub, digest[:], sig) {
+			t.Fatal("signature verification failed")
+		}
+
+		decoded, err := ecc.ElementFromECDSAPublicKey(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if _, err = g.NewElement().ToECDSAPublicKey(); err == nil {
+			t.Fatal("expected error on identity")
+		}
+
+		// Off-curve coordinates must be rejected.
+		pub.Y.Add(pub.Y, big.NewInt(1))
+		if _, err = ecc.ElementFromECDSAPublicKey(pub); !errors.Is(err, ecc.ErrPointNotOnCurve) {
+			t.Fatalf("expected off-curve error, got %v", err)
+		}
+	})
+}
+
+func TestElementFromECDSAPublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := ecc.ElementFromECDSAPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !e.Equal(ecc.P384Sha384.Base().Multiply(ecc.P384Sha384.NewScalar().SetBytesReduced(key.D.Bytes()))) {
+		t.Fatal(errExpectedEquality)
+	}
+
+	if _, err = ecc.ElementFromECDSAPublicKey(nil); err == nil {
+		t.Fatal("expected error on nil key")
+	}
+
+	// Coordinates larger than the field must be rejected.
+	large := &ecdsa.PublicKey{Curve: elliptic.P384(), X: new(big.Int).Lsh(key.X, 384), Y: key.Y}
+	if _, err = ecc.ElementFromECDSAPublicKey(large); !errors.Is(err, ecc.ErrPointNotOnCurve) {
+		t.Fatalf("expected off-curve error, got %v", err)
+	}
+}
+
+func TestECDSA_RFC6979(t *testing.T) {
+	// RFC 6979 appendix A.2.5, P-256 with SHA-256.
+	g := ecc.P256Sha256
+	sk := decodeScalar(t, g, "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	pk := g.Base().Multiply(sk)
+
+	for _, v := range []struct{ msg, r, s string }{
+		{
+			msg: "sample",
+			r:   "efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716",
+			s:   "f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8",
+		},
+		{
+			msg: "test",
+			r:   "f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367",
+			s:   "019f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083",
+		},
+	} {
+		r, s := eccdsa.Sign(g, sk, []byte(v.msg))
+		if hex.EncodeToString(r.Encode()) != v.r || hex.EncodeToString(s.Encode()) != v.s {
+			t.Fatalf("%s: unexpected signature (%s, %s)", v.msg, r.Hex(), s.Hex())
+		}
+
+		if !eccdsa.Verify(g, pk, []byte(v.msg), r, s) {
+			t.Fatalf("%s: signature verification failed", v.msg)
+		}
+	}
+}
+
+func TestECDSA_SignVerify(t *testing.T) {
+	msg := []byte("message")
+
+	for _, g := range ecdsaGroups {
+		sk := g.NewScalar().Random()
+		pk := g.Base().Multiply(sk)
+
+		r, s := eccdsa.Sign(g, sk, msg)
+		if !eccdsa.Verify(g, pk, msg, r, s) {
+			t.Fatalf("%s: signature verification failed", g)
+		}
+
+		// Nonces are deterministic.
+		r2, s2 := eccdsa.Sign(g, sk, msg)
+		if !r.Equal(r2) || !s.Equal(s2) {
+			t.Fatalf("%s: %v", g, errExpectedEquality)
+		}
+
+		if eccdsa.Verify(g, pk, []byte("other message"), r, s) || eccdsa.Verify(g, g.Base(), msg, r, s) {
+			t.Fatalf("%s: expected verification failure", g)
+		}
+
+		if eccdsa.Verify(g, g.NewElement(), msg, r, s) || eccdsa.Verify(g, nil, msg, r, s) ||
+			eccdsa.Verify(g, pk, msg, g.NewScalar(), s) || eccdsa.Verify(g, pk, msg, r, nil) {
+			t.Fatalf("%s: expected verification failure on invalid input", g)
+		}
+
+		// Low-s signatures.
+		rLow, sLow := eccdsa.Sign(g, sk, msg, eccdsa.WithLowS())
+		high := g.NewScalar().Subtract(sLow)
+
+		if !rLow.Equal(r) || (!sLow.Equal(s) && !high.Equal(s)) {
+			t.Fatalf("%s: unexpected low-s signature", g)
+		}
+
+		if !eccdsa.Verify(g, pk, msg, rLow, sLow, eccdsa.WithLowS()) || !eccdsa.Verify(g, pk, msg, rLow, high) ||
+			eccdsa.Verify(g, pk, msg, rLow, high, eccdsa.WithLowS()) {
+			t.Fatalf("%s: unexpected low-s verification", g)
+		}
+	}
+}
+
+func TestECDSA_Interop(t *testing.T) {
+	msg := []byte("message")
+
+	for _, g := range ecdsaGroups[:4] {
+		key, err := ecdsa.GenerateKey(map[ecc.Group]elliptic.Curve{
+			ecc.P224Sha256: elliptic.P224(),
+			ecc.P256Sha256: elliptic.P256(),
+			ecc.P384Sha384: elliptic.P384(),
+			ecc.P521Sha512: elliptic.P521(),
+		}[g], rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		h := g.HashFunc().New()
+		_, _ = h.Write(msg)
+		digest := h.Sum(nil)
+
+		sk := g.NewScalar().SetBytesReduced(key.D.Bytes())
+		pk, err := ecc.ElementFromECDSAPublicKey(&key.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Signatures from this package verify with crypto/ecdsa, and the other way around.
+		r, s := eccdsa.Sign(g, sk, msg)
+		if !ecdsa.Verify(&key.PublicKey, digest, new(big.Int).SetBytes(r.Encode()), new(big.Int).SetBytes(s.Encode())) {
+			t.Fatalf("%s: crypto/ecdsa verification failed", g)
+		}
+
+		rb, sb, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !eccdsa.Verify(g, pk, msg, g.NewScalar().SetBytesReduced(rb.Bytes()), g.NewScalar().SetBytesReduced(sb.Bytes())) {
+			t.Fatalf("%s: verification of crypto/ecdsa signature failed", g)
+		}
+	}
+}
+
+func TestECDSA_Unsupported(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if slices.Contains(ecdsaGroups, group.group) {
+			return
+		}
+
+		sk := group.group.NewScalar().Random()
+		if err := testPanic("unsupported group", errors.New("ECDSA is not supported for this group"), func() {
+			_, _ = eccdsa.Sign(group.group, sk, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if eccdsa.Verify(group.group, group.group.Base(), nil, sk, sk) {
+			t.Fatal("expected verification failure")
+		}
+	})
+}
+
+func TestECDSA_Recover(t *testing.T) {
+	msg := []byte("message")
+
+	for _, g := range ecdsaGroups {
+		sk := g.NewScalar().Random()
+		pk := g.Base().Multiply(sk)
+		h := g.HashFunc().New()
+		_, _ = h.Write(msg)
+		digest := h.Sum(nil)
+
+		// A signature with a known commitment R = k * G, and its recovery id.
+		k := g.NewScalar().Random()
+		x, y, err := g.Base().Multiply(k).AffineCoordinates()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		recID := y[len(y)-1] & 1
+		if new(big.Int).SetBytes(x).Cmp(g.OrderBigInt()) >= 0 {
+			recID |= 2
+		}
+
+		r := g.NewScalar().SetBytesReduced(x)
+		e := g.NewScalar().SetBytesReduced(digest[:min(len(digest), g.ScalarLength())])
+		s := r.Copy().MultiplyAdd(sk, e).Multiply(k.Invert())
+
+		if !eccdsa.Verify(g, pk, msg, r, s) {
+			t.Fatalf("%s: invalid test signature", g)
+		}
+
+		recovered, err := eccdsa.Recover(g, digest, r, s, recID)
+		if err != nil {
+			t.Fatalf("%s: %v", g, err)
+		}
+
+		if !recovered.Equal(pk) {
+			t.Fatalf("%s: recovered another public key", g)
+		}
+
+		// The other parity recovers another key, if any.
+		if other, err := eccdsa.Recover(g, digest, r, s, recID^1); err == nil && other.Equal(pk) {
+			t.Fatalf("%s: unexpected recovery with the other parity", g)
+		}
+
+		// Exactly one of the parities recovers the key of a signature of Sign.
+		r, s = eccdsa.Sign(g, sk, msg)
+		found := 0
+
+		for id := range byte(2) {
+			if p, err := eccdsa.Recover(g, digest, r, s, id); err == nil && p.Equal(pk) {
+				found++
+			}
+		}
+
+		if found != 1 {
+			t.Fatalf("%s: expected one recovery, got %d", g, found)
+		}
+
+		// High-s signatures are rejected with WithLowS.
+		high := s
+		if _, low := eccdsa.Sign(g, sk, msg, eccdsa.WithLowS()); low.Equal(s) {
+			high = g.NewScalar().Subtract(s)
+		}
+
+		for id := range byte(2) {
+			if _, err := eccdsa.Recover(g, digest, r, high, id, eccdsa.WithLowS()); !errors.Is(err, eccdsa.ErrInvalidSignature) {
+				t.Fatalf("%s: expected invalid signature error on high s, got %v", g, err)
+			}
+		}
+	}
+}
+
+func TestECDSA_Recover_Fails(t *testing.T) {
+	for _, g := range ecdsaGroups {
+		r := g.NewScalar().Random()
+		s := g.NewScalar().Random()
+		digest := make([]byte, g.HashFunc().Size())
+
+		// p - n reduced modulo n, for which r + n is p.
+		high := new(big.Int).Sub(new(big.Int).SetBytes(g.FieldPrime()), g.OrderBigInt())
+
+		for _, tc := range []struct {
+			r, s  *ecc.Scalar
+			recID byte
+		}{
+			{r, s, 4},
+			{nil, s, 0},
+			{r, nil, 0},
+			{g.NewScalar(), s, 0},
+			{r, g.NewScalar(), 1},
+			{g.NewScalar().SetBytesReduced(high.Bytes()), s, 2},
+			{g.NewScalar().SetBytesReduced(high.Bytes()), s, 3},
+		} {
+			if _, err := eccdsa.Recover(g, digest, tc.r, tc.s, tc.recID); !errors.Is(err, eccdsa.ErrInvalidSignature) {
+				t.Fatalf("%s: expected invalid signature error, got %v", g, err)
+			}
+		}
+	}
+
+	if _, err := eccdsa.Recover(ecc.Ristretto255Sha512, nil, nil, nil, 0); err == nil {
+		t.Fatal("expected error on unsupported group")
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestEd25519PublicKey(t *testing.T) {
+	g := ecc.Edwards25519Sha512
+
+	for range 16 {
+		pk, sk, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		e, err := ecc.Ed25519PublicKeyToElement(pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The public key is the base point multiplied by the clamped hash of the seed.
+		h := sha512.Sum512(sk.Seed())
+		h[0] &= 248
+		h[31] &= 127
+		h[31] |= 64
+
+		if !e.Equal(g.Base().Multiply(g.NewScalar().SetBytesReduced(internal.Reverse(h[:32])))) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !bytes.Equal(pk, e.ToEd25519PublicKey()) {
+			t.Fatal(errExpectedEquality)
+		}
+	}
+
+	// Elements from this package verify signatures made with the corresponding crypto/ed25519 key.
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		t.Fatal(err)
+	}
+
+	sk := ed25519.NewKeyFromSeed(seed)
+	e, err := ecc.Ed25519PublicKeyToElement(sk.Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg := []byte("message")
+	if !ed25519.Verify(e.ToEd25519PublicKey(), msg, ed25519.Sign(sk, msg)) {
+		t.Fatal("signature verification failed")
+	}
+}
+
+func TestEd25519PublicKey_Fails(t *testing.T) {
+	if _, err := ecc.Ed25519PublicKeyToElement(make([]byte, 31)); !errors.Is(err, internal.ErrDecodingInvalidLength) {
+		t.Fatalf("expected length error, got %v", err)
+	}
+
+	if _, err := ecc.Ed25519PublicKeyToElement(ecc.Edwards25519Sha512.NewElement().Encode()); err == nil {
+		t.Fatal("expected error on identity")
+	}
+
+	if ecc.Edwards25519Sha512.NewElement().ToEd25519PublicKey() != nil {
+		t.Fatal("expected nil public key for the identity")
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		if group.group != ecc.Edwards25519Sha512 && group.group.Base().ToEd25519PublicKey() != nil {
+			t.Fatal("expected nil public key for other groups")
+		}
+	})
+}
+
+func TestVerifyEd25519Batch(t *testing.T) {
+	n := 5
+	pubs := make([]ed25519.PublicKey, n)
+	messages := make([][]byte, n)
+	signatures := make([][]byte, n)
+
+	for i := range n {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pubs[i] = pub
+		messages[i] = []byte(fmt.Sprintf("message %d", i))
+		signatures[i] = ed25519.Sign(priv, messages[i])
+	}
+
+	if !ecc.VerifyEd25519Batch(pubs, messages, signatures) {
+		t.Fatal("batch verification failed")
+	}
+
+	if ecc.VerifyEd25519Batch(nil, nil, nil) || ecc.VerifyEd25519Batch(pubs, messages[1:], signatures) {
+		t.Fatal("expected failure on invalid input lengths")
+	}
+
+	// A single bad signature fails the batch.
+	bad := bytes.Clone(signatures[2])
+	bad[40] ^= 1
+	if ecc.VerifyEd25519Batch(pubs, messages, [][]byte{signatures[0], signatures[1], bad, signatures[3], signatures[4]}) {
+		t.Fatal("expected batch verification failure")
+	}
+
+	messages[3] = []byte("other message")
+	if ecc.VerifyEd25519Batch(pubs, messages, signatures) {
+		t.Fatal("expected batch verification failure on a wrong message")
+	}
+
+	if ecc.VerifyEd25519Batch(pubs, messages, append(signatures[:4:4], signatures[4][:63])) {
+		t.Fatal("expected batch verification failure on a truncated signature")
+	}
+}
@@ -9,9 +9,16 @@
 package ecc_test
 
 import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/elliptic"
+	"crypto/subtle"
 	"encoding/hex"
 	"errors"
+	"io"
 	"log"
+	"math/big"
+	"slices"
 	"testing"
 
 	"github.com/0xBridge/ecc"
@@ -105,9 +112,11 @@ func TestElement_WrongInput(t *testing.T) {
 
 		switch group.group {
 		// The following is arbitrary, and simply aims at confusing identifiers
-		case ecc.Ristretto255Sha512, ecc.Edwards25519Sha512:
+		case ecc.Ristretto255Sha512, ecc.Decaf448Shake256, ecc.Edwards25519Sha512, ecc.Edwards448Shake256,
+			ecc.PallasSha256, ecc.VestaSha256:
 			alternativeGroup = ecc.P256Sha256
-		case ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512, ecc.Secp256k1Sha256:
+		case ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512, ecc.Secp256k1Sha256, ecc.P224Sha256,
+			ecc.BLS12381G1Sha256:
 			alternativeGroup = ecc.Ristretto255Sha512
 		default:
 			t.Fatalf("Invalid group id %d", group.group)
@@ -179,6 +188,8 @@ func TestElement_Decode_Identity(t *testing.T) {
 		switch group.group {
 		case ecc.Ristretto255Sha512:
 			errMessage = "invalid Ristretto encoding: infinity/identity point"
+		case ecc.Decaf448Shake256:
+			errMessage = "invalid Decaf448 encoding: infinity/identity point"
 		case ecc.P256Sha256:
 			errMessage = "invalid P256 point encoding"
 		case ecc.P384Sha384:
@@ -187,8 +198,18 @@ func TestElement_Decode_Identity(t *testing.T) {
 			errMessage = "invalid P521 point encoding"
 		case ecc.Edwards25519Sha512:
 			errMessage = "invalid edwards25519 encoding: infinity/identity point"
+		case ecc.Edwards448Shake256:
+			errMessage = "invalid edwards448 encoding: infinity/identity point"
 		case ecc.Secp256k1Sha256:
 			errMessage = "invalid secp256k1 encoding: invalid point encoding"
+		case ecc.P224Sha256:
+			errMessage = "invalid P224 point encoding"
+		case ecc.BLS12381G1Sha256:
+			errMessage = "invalid BLS12-381 G1 encoding: infinity/identity point"
+		case ecc.PallasSha256:
+			errMessage = "invalid Pallas encoding: infinity/identity point"
+		case ecc.VestaSha256:
+			errMessage = "invalid Vesta encoding: infinity/identity point"
 		}
 
 		decodeErr += errMessage
@@ -206,6 +227,259 @@ func TestElement_Decode_Identity(t *testing.T) {
 	})
 }
 
+func TestElement_Decode_IdentityByte(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		// The NIST library decodes a single 0x00 byte to the identity, which must be rejected as in the other groups.
+		if err := group.group.NewElement().Decode([]byte{0}); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestElement_DecodeAllowIdentity(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		id := g.NewElement()
+
+		e := randomElement(g)
+		if err := e.DecodeAllowIdentity(id.Encode()); err != nil {
+			t.Fatal(err)
+		}
+
+		if !e.IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		r := randomElement(g)
+		if err := e.DecodeAllowIdentity(r.Encode()); err != nil {
+			t.Fatal(err)
+		}
+
+		if !e.Equal(r) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Decode stays strict.
+		if err := g.NewElement().Decode(id.Encode()); err == nil {
+			t.Fatal("expected error")
+		}
+
+		for _, data := range [][]byte{nil, {0}, id.Encode()[1:], append(id.Encode(), 0)} {
+			if err := e.DecodeAllowIdentity(data); err == nil {
+				t.Fatalf("expected error for %x", data)
+			}
+
+			if !e.Equal(r) {
+				t.Fatal("the receiver must not be modified on error")
+			}
+		}
+	})
+}
+
+// edwards25519SmallOrder are the encodings of the points of small order on Edwards25519.
+var edwards25519SmallOrder = []string{
+	"0100000000000000000000000000000000000000000000000000000000000000", // order 1 (identity)
+	"ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f", // order 2
+	"0000000000000000000000000000000000000000000000000000000000000000", // order 4
+	"0000000000000000000000000000000000000000000000000000000000000080", // order 4
+	"26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc05", // order 8
+	"26e8958fc2b227b045c3f489f2ef98f0d5dfac05d3c63339b13802886d53fc85", // order 8
+	"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac037a", // order 8
+	"c7176a703d4dd84fba3c0b760d10670f2a2053fa2c39ccc64ec7fd7792ac03fa", // order 8
+}
+
+// edwards448SmallOrder are the encodings of the points of small order on Edwards448.
+var edwards448SmallOrder = []string{
+	"010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", // order 1 (identity)
+	"fefffffffffffffffffffffffffffffffffffffffffffffffffffffffeffffffffffffffffffffffffffffffffffffffffffffffffffffff00", // order 2
+	"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000", // order 4
+	"000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000080", // order 4
+}
+
+const (
+	bls12381FieldOrder = "1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab"
+	bls12381G1Cofactor = "00000000000000000000000000000000396c8c005555e1568c00aaab0000aaab"
+	pallasFieldOrder   = "40000000000000000000000000000000224698fc094cf91b992d30ed00000001"
+	vestaFieldOrder    = "40000000000000000000000000000000224698fc0994a8dd8c46eb2100000001"
+
+	// bls12381G1Order3 encodes (0, 2), a point of order 3 on the BLS12-381 G1 curve, outside of the prime-order subgroup.
+	bls12381G1Order3 = "800000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"
+)
+
+func TestElement_IsValid(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if g.NewElement().IsValid() {
+			t.Fatal("the identity must not be valid")
+		}
+
+		if !g.Base().IsValid() {
+			t.Fatal("the base point must be valid")
+		}
+
+		for range 10 {
+			e := randomElement(g)
+			if !e.IsValid() {
+				t.Fatal("expected valid element")
+			}
+
+			d := g.NewElement()
+			if err := d.Decode(e.Encode()); err != nil {
+				t.Fatal(err)
+			}
+
+			if !d.IsValid() {
+				t.Fatal("expected valid decoded element")
+			}
+		}
+	})
+}
+
+func TestElement_IsValid_Edwards25519SmallOrder(t *testing.T) {
+	testIsValidSmallOrder(t, ecc.Edwards25519Sha512, edwards25519SmallOrder, 8)
+}
+
+func TestElement_IsValid_Edwards448SmallOrder(t *testing.T) {
+	testIsValidSmallOrder(t, ecc.Edwards448Shake256, edwards448SmallOrder, 4)
+}
+
+func testIsValidSmallOrder(t *testing.T, g ecc.Group, smallOrder []string, cofactor uint64) {
+	base := g.Base()
+
+	for _, h := range smallOrder {
+		b, err := hex.DecodeString(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		small := g.NewElement()
+		if err = small.Decode(b); err != nil {
+			// Only the identity is rejected by Decode.
+			if !bytes.Equal(b, g.NewElement().Encode()) {
+				t.Fatalf("unexpected decoding error for %s: %v", h, err)
+			}
+
+			continue
+		}
+
+		if small.IsValid() {
+			t.Fatalf("small order point %s must not be valid", h)
+		}
+
+		// The point is of small order, and is thus cleared by multiplying with the cofactor.
+		if !small.Copy().Multiply(g.NewScalar().SetUInt64(cofactor)).IsIdentity() {
+			t.Fatalf("expected %s to be of small order", h)
+		}
+
+		// Mixed-order points, i.e. a prime-order point plus a small-order point, must not be valid.
+		mixed := base.Copy().Add(small)
+		if mixed.IsValid() {
+			t.Fatalf("mixed order point with %s must not be valid", h)
+		}
+
+		decoded := g.NewElement()
+		if err = decoded.Decode(mixed.Encode()); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.IsValid() {
+			t.Fatalf("decoded mixed order point with %s must not be valid", h)
+		}
+	}
+}
+
+func TestElement_Decode_BLS12381G1NotInSubgroup(t *testing.T) {
+	g := ecc.BLS12381G1Sha256
+
+	encoded, err := hex.DecodeString(bls12381G1Order3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = g.NewElement().Decode(encoded); !errors.Is(err, internal.ErrPointNotInSubgroup) {
+		t.Fatalf("expected subgroup error, got %v", err)
+	}
+
+	if err = g.NewElement().DecodeAllowIdentity(encoded); !errors.Is(err, internal.ErrPointNotInSubgroup) {
+		t.Fatalf("expected subgroup error, got %v", err)
+	}
+}
+
+func TestElement_IsBasePoint(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if !g.Base().IsBasePoint() || !g.NewElement().Base().IsBasePoint() {
+			t.Fatal("expected the base point")
+		}
+
+		if g.NewElement().IsBasePoint() || g.Base().Double().IsBasePoint() || g.Base().Negate().IsBasePoint() {
+			t.Fatal("unexpected base point")
+		}
+
+		// The cached base point must not be altered by operations on base points.
+		e := g.Base()
+		e.Add(g.Base()).Multiply(g.RandomScalar())
+
+		if !g.Base().IsBasePoint() || !g.Base().Equal(g.ScalarBaseMult(g.NewScalar().One())) {
+			t.Fatal("expected the base point")
+		}
+	})
+}
+
+func TestElement_ClearCofactor(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		cofactor := g.NewScalar().One()
+		switch g {
+		case ecc.Edwards25519Sha512:
+			cofactor.SetUInt64(8)
+		case ecc.Edwards448Shake256:
+			cofactor.SetUInt64(4)
+		case ecc.BLS12381G1Sha256:
+			if err := cofactor.DecodeHex(bls12381G1Cofactor); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		e := randomElement(g)
+		if !e.Copy().ClearCofactor().Equal(e.Copy().Multiply(cofactor)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.NewElement().ClearCofactor().IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+	})
+}
+
+func TestElement_ClearCofactor_Edwards25519SmallOrder(t *testing.T) {
+	testClearCofactorSmallOrder(t, ecc.Edwards25519Sha512, edwards25519SmallOrder, 8)
+}
+
+func TestElement_ClearCofactor_Edwards448SmallOrder(t *testing.T) {
+	testClearCofactorSmallOrder(t, ecc.Edwards448Shake256, edwards448SmallOrder, 4)
+}
+
+func testClearCofactorSmallOrder(t *testing.T, g ecc.Group, smallOrder []string, cofactor uint64) {
+	e := randomElement(g)
+	cleared := e.Copy().Multiply(g.NewScalar().SetUInt64(cofactor))
+
+	for _, h := range smallOrder[1:] {
+		small := decodeElement(t, g, h)
+
+		if !small.Copy().ClearCofactor().IsIdentity() {
+			t.Fatalf("expected %s to be cleared to the identity", h)
+		}
+
+		mixed := e.Copy().Add(small).ClearCofactor()
+		if !mixed.IsValid() || !mixed.Equal(cleared) {
+			t.Fatalf("expected the small order component %s to be cleared", h)
+		}
+	}
+}
+
 func TestElement_Decode_Bad(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		decodePrefix := "element Decode: "
@@ -214,6 +488,8 @@ func TestElement_Decode_Bad(t *testing.T) {
 		switch group.group {
 		case ecc.Ristretto255Sha512:
 			errMessage = "invalid Ristretto encoding"
+		case ecc.Decaf448Shake256:
+			errMessage = "invalid Decaf448 encoding: invalid point encoding"
 		case ecc.P256Sha256:
 			errMessage = "invalid P256 element encoding"
 		case ecc.P384Sha384:
@@ -222,8 +498,18 @@ func TestElement_Decode_Bad(t *testing.T) {
 			errMessage = "invalid P521Element encoding"
 		case ecc.Edwards25519Sha512:
 			errMessage = "edwards25519: invalid point encoding"
+		case ecc.Edwards448Shake256:
+			errMessage = "invalid edwards448 encoding: invalid point encoding"
 		case ecc.Secp256k1Sha256:
 			errMessage = "invalid secp256k1 encoding: invalid point encoding"
+		case ecc.P224Sha256:
+			errMessage = "invalid P224Element encoding"
+		case ecc.BLS12381G1Sha256:
+			errMessage = "invalid BLS12-381 G1 encoding: invalid point encoding"
+		case ecc.PallasSha256:
+			errMessage = "invalid Pallas encoding: invalid point encoding"
+		case ecc.VestaSha256:
+			errMessage = "invalid Vesta encoding: invalid point encoding"
 		}
 
 		// off curve
@@ -247,6 +533,8 @@ func TestElement_Decode_Bad(t *testing.T) {
 			errMessage = "invalid P384 point encoding"
 		case ecc.P521Sha512:
 			errMessage = "invalid P521 point encoding"
+		case ecc.P224Sha256:
+			errMessage = "invalid P224 compressed point encoding"
 		}
 
 		bad = debug.BadElementEncoding(group.group)
@@ -281,6 +569,512 @@ func TestElement_XCoordinate(t *testing.T) {
 	})
 }
 
+// curveEquation returns the curve's left and right hand sides evaluated at the affine x and y coordinates returned by
+// XCoordinate and YCoordinate, and false if the group does not expose coordinates.
+func curveEquation(g ecc.Group, e *ecc.Element) (lhs, rhs *big.Int, ok bool) {
+	var p, b *big.Int
+
+	x := new(big.Int).SetBytes(e.XCoordinate())
+	y := new(big.Int).SetBytes(e.YCoordinate())
+
+	switch g {
+	case ecc.P256Sha256:
+		p, b = elliptic.P256().Params().P, elliptic.P256().Params().B
+	case ecc.P384Sha384:
+		p, b = elliptic.P384().Params().P, elliptic.P384().Params().B
+	case ecc.P521Sha512:
+		p, b = elliptic.P521().Params().P, elliptic.P521().Params().B
+	case ecc.Secp256k1Sha256:
+		p, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f", 16)
+		b = big.NewInt(7)
+	case ecc.BLS12381G1Sha256:
+		p, _ = new(big.Int).SetString(bls12381FieldOrder, 16)
+		b = big.NewInt(4)
+	case ecc.PallasSha256:
+		// The Pasta coordinates are little-endian.
+		p, _ = new(big.Int).SetString(pallasFieldOrder, 16)
+		b = big.NewInt(5)
+		x.SetBytes(internal.Reverse(e.XCoordinate()))
+		y.SetBytes(internal.Reverse(e.YCoordinate()))
+	case ecc.VestaSha256:
+		p, _ = new(big.Int).SetString(vestaFieldOrder, 16)
+		b = big.NewInt(5)
+		x.SetBytes(internal.Reverse(e.XCoordinate()))
+		y.SetBytes(internal.Reverse(e.YCoordinate()))
+	case ecc.Edwards25519Sha512:
+		// Montgomery form: v^2 = u^3 + 486662u^2 + u.
+		p = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+		u := new(big.Int).SetBytes(internal.Reverse(e.XCoordinate()))
+		v := new(big.Int).SetBytes(internal.Reverse(e.YCoordinate()))
+		lhs = new(big.Int).Mul(v, v)
+		rhs = new(big.Int).Mul(u, u)
+		rhs.Mul(rhs, new(big.Int).Add(u, big.NewInt(486662))).Add(rhs, u)
+
+		return lhs.Mod(lhs, p), rhs.Mod(rhs, p), true
+	case ecc.Edwards448Shake256:
+		// Montgomery form: v^2 = u^3 + 156326u^2 + u.
+		p = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 448), new(big.Int).Lsh(big.NewInt(1), 224))
+		p.Sub(p, big.NewInt(1))
+		u := new(big.Int).SetBytes(internal.Reverse(e.XCoordinate()))
+		v := new(big.Int).SetBytes(internal.Reverse(e.YCoordinate()))
+		lhs = new(big.Int).Mul(v, v)
+		rhs = new(big.Int).Mul(u, u)
+		rhs.Mul(rhs, new(big.Int).Add(u, big.NewInt(156326))).Add(rhs, u)
+
+		return lhs.Mod(lhs, p), rhs.Mod(rhs, p), true
+	default:
+		return nil, nil, false
+	}
+
+	// Weierstrass form: y^2 = x^3 + ax + b, with a = -3 for the NIST curves, and 0 for the others.
+	lhs = new(big.Int).Mul(y, y)
+	rhs = new(big.Int).Mul(x, x)
+	rhs.Mul(rhs, x).Add(rhs, b)
+
+	if g == ecc.P256Sha256 || g == ecc.P384Sha384 || g == ecc.P521Sha512 {
+		rhs.Sub(rhs, new(big.Int).Mul(big.NewInt(3), x))
+	}
+
+	return lhs.Mod(lhs, p), rhs.Mod(rhs, p), true
+}
+
+func TestElement_YCoordinate(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if g == ecc.Ristretto255Sha512 || g == ecc.Decaf448Shake256 {
+			if g.Base().YCoordinate() != nil {
+				t.Fatal("expected nil y coordinate")
+			}
+
+			return
+		}
+
+		for _, e := range []*ecc.Element{g.Base(), randomElement(g), randomElement(g).Negate()} {
+			y := e.YCoordinate()
+			if len(y) != len(e.XCoordinate()) {
+				t.Fatalf("unexpected y coordinate length %d", len(y))
+			}
+
+			lhs, rhs, _ := curveEquation(g, e)
+			if lhs.Cmp(rhs) != 0 {
+				t.Fatal("coordinates are not on the curve")
+			}
+
+			// The compressed SEC1 encoding of Weierstrass points carries the parity of y.
+			if g != ecc.Edwards25519Sha512 && g != ecc.Edwards448Shake256 && g != ecc.BLS12381G1Sha256 &&
+				g != ecc.PallasSha256 && g != ecc.VestaSha256 && e.Encode()[0]&1 != y[len(y)-1]&1 {
+				t.Fatal("unexpected parity of the y coordinate")
+			}
+		}
+
+		// The y coordinate of the identity is 0.
+		if !bytes.Equal(g.NewElement().YCoordinate(), make([]byte, len(g.Base().XCoordinate()))) {
+			t.Fatal("expected zero y coordinate for the identity")
+		}
+
+		if g == ecc.P256Sha256 {
+			expected := "4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5"
+			if hex.EncodeToString(g.Base().YCoordinate()) != expected {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+	})
+}
+
+func TestElement_AffineCoordinates(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := randomElement(g)
+
+		x, y, err := e.AffineCoordinates()
+
+		if g == ecc.Ristretto255Sha512 || g == ecc.Decaf448Shake256 || g == ecc.Edwards25519Sha512 ||
+			g == ecc.Edwards448Shake256 || g == ecc.BLS12381G1Sha256 || g == ecc.PallasSha256 ||
+			g == ecc.VestaSha256 {
+			if !errors.Is(err, errors.ErrUnsupported) || x != nil || y != nil {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			return
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(x, e.XCoordinate()) || !bytes.Equal(y, e.YCoordinate()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if _, _, err = g.NewElement().AffineCoordinates(); !errors.Is(err, internal.ErrIdentity) {
+			t.Fatalf("expected identity error, got %v", err)
+		}
+	})
+}
+
+func TestElement_Uncompressed(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := randomElement(g)
+
+		if g == ecc.Ristretto255Sha512 || g == ecc.Decaf448Shake256 || g == ecc.Edwards25519Sha512 ||
+			g == ecc.Edwards448Shake256 || g == ecc.BLS12381G1Sha256 || g == ecc.PallasSha256 ||
+			g == ecc.VestaSha256 {
+			if _, err := e.EncodeUncompressed(); !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			if err := g.NewElement().DecodeUncompressed(e.Encode()); !errors.Is(err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			return
+		}
+
+		encoded, err := e.EncodeUncompressed()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(encoded) != 2*g.ElementLength()-1 || encoded[0] != 0x04 {
+			t.Fatal("unexpected uncompressed encoding")
+		}
+
+		decoded := g.NewElement()
+		if err = decoded.DecodeUncompressed(encoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if _, err = g.NewElement().EncodeUncompressed(); !errors.Is(err, internal.ErrIdentity) {
+			t.Fatalf("expected identity error, got %v", err)
+		}
+
+		// Bad prefix, length, and points off the curve must fail and leave the receiver untouched.
+		bad := slices.Clone(encoded)
+		bad[0] = 0x02
+		offCurve := slices.Clone(encoded)
+		offCurve[len(offCurve)-1] ^= 0x02
+
+		for _, data := range [][]byte{nil, encoded[:len(encoded)-1], e.Encode(), bad, offCurve} {
+			if err = decoded.DecodeUncompressed(data); err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !decoded.Equal(e) {
+				t.Fatal("the receiver must not be modified on error")
+			}
+		}
+
+		if err = decoded.DecodeUncompressed(offCurve); !errors.Is(err, internal.ErrPointNotOnCurve) {
+			t.Fatalf("expected not on curve error, got %v", err)
+		}
+	})
+}
+
+func TestElement_SetCoordinates(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := randomElement(g)
+
+		if g == ecc.Ristretto255Sha512 || g == ecc.Decaf448Shake256 || g == ecc.Edwards25519Sha512 ||
+			g == ecc.Edwards448Shake256 || g == ecc.BLS12381G1Sha256 || g == ecc.PallasSha256 ||
+			g == ecc.VestaSha256 {
+			if err := g.NewElement().SetCoordinates(e.XCoordinate(), e.YCoordinate()); !errors.Is(
+				err, errors.ErrUnsupported) {
+				t.Fatalf("expected unsupported error, got %v", err)
+			}
+
+			return
+		}
+
+		x, y, err := e.AffineCoordinates()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		p := g.NewElement()
+		if err = p.SetCoordinates(x, y); err != nil {
+			t.Fatal(err)
+		}
+
+		if !p.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Invalid lengths and points off the curve must fail and leave the receiver untouched.
+		offCurve := slices.Clone(y)
+		offCurve[len(offCurve)-1] ^= 0x02
+
+		for _, c := range [][2][]byte{{nil, y}, {x, nil}, {x[1:], y}, {x, append(slices.Clone(y), 0)}, {x, offCurve}} {
+			if err = p.SetCoordinates(c[0], c[1]); err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !p.Equal(e) {
+				t.Fatal("the receiver must not be modified on error")
+			}
+		}
+
+		if err = p.SetCoordinates(x, offCurve); !errors.Is(err, ecc.ErrPointNotOnCurve) {
+			t.Fatalf("expected not on curve error, got %v", err)
+		}
+	})
+}
+
+func TestElement_Uncompressed_Vectors(t *testing.T) {
+	vectors := map[ecc.Group]string{
+		ecc.P256Sha256: "046b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296" +
+			"4fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f5",
+		ecc.Secp256k1Sha256: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+			"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+	}
+
+	for g, v := range vectors {
+		encoded, err := g.Base().EncodeUncompressed()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if hex.EncodeToString(encoded) != v {
+			t.Fatalf("%s: unexpected uncompressed base point encoding", g)
+		}
+	}
+
+	// Compare with the uncompressed public keys of crypto/ecdh.
+	for g, curve := range map[ecc.Group]ecdh.Curve{
+		ecc.P256Sha256: ecdh.P256(),
+		ecc.P384Sha384: ecdh.P384(),
+		ecc.P521Sha512: ecdh.P521(),
+	} {
+		sk := g.NewScalar().Random()
+
+		key, err := curve.NewPrivateKey(sk.Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoded, err := g.Base().Multiply(sk).EncodeUncompressed()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(encoded, key.PublicKey().Bytes()) {
+			t.Fatalf("%s: unexpected uncompressed encoding", g)
+		}
+	}
+}
+
+func TestElement_CMov(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := randomElement(g), randomElement(g)
+		aCopy, bCopy := a.Copy(), b.Copy()
+
+		if !a.Copy().CMov(b, 0).Equal(a) {
+			t.Fatal("expected the receiver to be unchanged")
+		}
+
+		if !a.Copy().CMov(b, 1).Equal(b) {
+			t.Fatal("expected the receiver to be set to the argument")
+		}
+
+		// The identity can be selected, and replaced.
+		if !a.Copy().CMov(g.NewElement(), 1).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !g.NewElement().CMov(a, 0).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !g.NewElement().CMov(a, 1).Equal(a) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Selecting from a table.
+		table := []*ecc.Element{g.NewElement(), a, b, g.Base()}
+		for i, expected := range table {
+			selected := g.NewElement()
+			for j, e := range table {
+				selected.CMov(e, subtle.ConstantTimeEq(int32(i), int32(j)))
+			}
+
+			if !selected.Equal(expected) {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		if !a.Equal(aCopy) || !b.Equal(bCopy) {
+			t.Fatal("unexpected modification of the input")
+		}
+
+		if err := testPanic("nil element", internal.ErrParamNilPoint, func() { a.CMov(nil, 1) }); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestElement_CondNegate(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a := g.Base().Multiply(g.NewScalar().Random())
+		aCopy := a.Copy()
+
+		if !a.Copy().CondNegate(0).Equal(a) {
+			t.Fatal("expected the element to be unchanged for choice 0")
+		}
+
+		if !a.Copy().CondNegate(1).Equal(a.Copy().Negate()) {
+			t.Fatal("expected the element to be negated for choice 1")
+		}
+
+		if !a.Copy().CondNegate(1).CondNegate(1).Equal(a) {
+			t.Fatal("expected a double negation to be the element")
+		}
+
+		if !g.NewElement().CondNegate(1).IsIdentity() || !g.NewElement().CondNegate(0).IsIdentity() {
+			t.Fatal("expected the identity to stay the identity")
+		}
+
+		if !a.Equal(aCopy) {
+			t.Fatal("unexpected modification of the element")
+		}
+	})
+}
+
+func TestElement_CSwap(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.Base().Multiply(g.NewScalar().Random()), g.NewElement()
+		aCopy, bCopy := a.Copy(), b.Copy()
+
+		a.CSwap(b, 0)
+		if !a.Equal(aCopy) || !b.Equal(bCopy) {
+			t.Fatal("expected no swap for choice 0")
+		}
+
+		a.CSwap(b, 1)
+		if !a.Equal(bCopy) || !b.Equal(aCopy) {
+			t.Fatal("expected a swap for choice 1")
+		}
+
+		a.CSwap(b, 1)
+		if !a.Equal(aCopy) || !b.Equal(bCopy) {
+			t.Fatal("expected two swaps to restore the elements")
+		}
+
+		if err := testPanic("nil element", internal.ErrParamNilPoint, func() { a.CSwap(nil, 1) }); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestElement_MultiplyBig(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := g.Base().Multiply(g.NewScalar().Random())
+		order := g.OrderBigInt()
+
+		for _, k := range []*big.Int{
+			big.NewInt(0), big.NewInt(1), big.NewInt(-7), order, new(big.Int).Add(order, big.NewInt(2)),
+			new(big.Int).Lsh(big.NewInt(1), 512), new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(3), 400)),
+		} {
+			expected := e.Copy().Multiply(g.NewScalar().SetBigInt(k))
+			if !e.Copy().MultiplyBig(k).Equal(expected) {
+				t.Fatalf("expected MultiplyBig(%s) to match Multiply(SetBigInt(%s))", k, k)
+			}
+
+			// -k * e = k * -e
+			negK := new(big.Int).Neg(k)
+			if !e.Copy().MultiplyBig(negK).Equal(e.Copy().Negate().MultiplyBig(k)) {
+				t.Fatalf("expected MultiplyBig(-%s) to be the negation", k)
+			}
+
+			// The base point goes through the fixed-base multiplication.
+			if !g.Base().MultiplyBig(k).Equal(g.ScalarBaseMult(g.NewScalar().SetBigInt(k))) {
+				t.Fatalf("expected Base().MultiplyBig(%s) to match ScalarBaseMult", k)
+			}
+		}
+
+		if !e.Copy().MultiplyBig(nil).IsIdentity() {
+			t.Fatal("expected the identity for a nil multiplier")
+		}
+	})
+}
+
+func TestElement_RandomFromReader(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		seed := bytes.Repeat([]byte{0x5a}, g.ScalarLength()+16)
+
+		e, err := g.NewElement().RandomFromReader(bytes.NewReader(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s, err := g.NewScalar().RandomFromReader(bytes.NewReader(seed))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if e.IsIdentity() || !e.Equal(g.Base().Multiply(s)) {
+			t.Fatal("expected the element to be the base point times the scalar read")
+		}
+
+		if _, err = g.NewElement().RandomFromReader(bytes.NewReader(nil)); !errors.Is(err, io.EOF) {
+			t.Fatalf("expected %q, got %v", io.EOF, err)
+		}
+	})
+}
+
+func TestElement_AddInto(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.RandomElement(), g.RandomElement()
+		aCopy, bCopy := a.Copy(), b.Copy()
+		expected := a.Copy().Add(b)
+
+		dst := g.RandomElement()
+		if dst.AddInto(a, b) != dst || !dst.Equal(expected) || !a.Equal(aCopy) || !b.Equal(bCopy) {
+			t.Fatal("unexpected result or modification of the operands")
+		}
+
+		// Aliasing of the receiver with either operand, or both.
+		if !a.AddInto(a, b).Equal(expected) || !b.Equal(bCopy) {
+			t.Fatal("unexpected result for dst == a")
+		}
+
+		a.Set(aCopy)
+		if !b.AddInto(a, b).Equal(expected) || !a.Equal(aCopy) {
+			t.Fatal("unexpected result for dst == b")
+		}
+
+		if !a.AddInto(a, a).Equal(aCopy.Copy().Double()) {
+			t.Fatal("unexpected result for dst == a == b")
+		}
+
+		if err := testPanic("nil element", internal.ErrParamNilPoint, func() { dst.AddInto(a, nil) }); err != nil {
+			t.Fatal(err)
+		}
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastElement, func() {
+			wrongGroup.NewElement().AddInto(a, b)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestElement_Vectors_Add(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		base := group.group.Base()
@@ -346,6 +1140,147 @@ func TestElement_Vectors_Mult(t *testing.T) {
 	})
 }
 
+func TestElement_MultiplyVartime_Ranges(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := randomElement(g)
+
+		// Consecutive scalars starting at zero, ending at the order minus one, and around a random scalar, compared
+		// to the constant-time product incremented by e at each step.
+		ranges := []struct {
+			start *ecc.Scalar
+			count int
+		}{
+			{g.NewScalar(), 1024},
+			{g.NewScalar().Subtract(g.NewScalar().SetUInt64(128)), 128},
+			{g.NewScalar().Random(), 256},
+		}
+
+		for _, r := range ranges {
+			s := r.start.Copy()
+			expected := e.Copy().Multiply(s)
+
+			for range r.count {
+				if !e.Copy().MultiplyVartime(s).Equal(expected) {
+					t.Fatalf("expected equality for scalar %s", s.Hex())
+				}
+
+				s.Add(g.NewScalar().One())
+				expected.Add(e)
+			}
+		}
+	})
+}
+
+func TestElement_MultiplyVartime(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := randomElement(g)
+		orderMinusOne := g.NewScalar().Subtract(g.NewScalar().One())
+
+		scalars := []*ecc.Scalar{g.NewScalar(), g.NewScalar().One(), g.NewScalar().SetUInt64(2), orderMinusOne}
+		for range 32 {
+			scalars = append(scalars, g.NewScalar().Random())
+		}
+
+		for _, s := range scalars {
+			if !e.Copy().MultiplyVartime(s).Equal(e.Copy().Multiply(s)) {
+				t.Fatalf("expected equality for scalar %s", s.Hex())
+			}
+		}
+
+		if !g.NewElement().MultiplyVartime(g.NewScalar().Random()).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !e.Copy().MultiplyVartime(nil).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		alternativeGroup := ecc.P256Sha256
+		if g == alternativeGroup {
+			alternativeGroup = ecc.Ristretto255Sha512
+		}
+
+		if err := testPanic(errWrongGroup, internal.ErrCastScalar, func() {
+			e.MultiplyVartime(alternativeGroup.NewScalar())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestElement_Precompute(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		e := randomElement(g)
+		p := e.Precompute()
+
+		if p.Group() != g || !p.Element().Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		orderMinusOne := g.NewScalar().Subtract(g.NewScalar().One())
+		scalars := []*ecc.Scalar{g.NewScalar(), g.NewScalar().One(), orderMinusOne}
+
+		for range 32 {
+			scalars = append(scalars, g.NewScalar().Random())
+		}
+
+		for _, s := range scalars {
+			if !p.Multiply(s).Equal(e.Copy().Multiply(s)) {
+				t.Fatalf("expected equality for scalar %s", s.Hex())
+			}
+		}
+
+		if !p.Multiply(nil).IsIdentity() || !g.NewElement().Precompute().Multiply(orderMinusOne).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		alternativeGroup := ecc.P256Sha256
+		if g == alternativeGroup {
+			alternativeGroup = ecc.Ristretto255Sha512
+		}
+
+		if err := testPanic(errWrongGroup, internal.ErrCastScalar, func() {
+			p.Multiply(alternativeGroup.NewScalar())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGroup_BaseTable(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		table := g.BaseTable()
+
+		if table != g.BaseTable() || !table.Element().Equal(g.Base()) {
+			t.Fatal("expected a shared table of the base point")
+		}
+
+		orderMinusOne := g.NewScalar().Subtract(g.NewScalar().One())
+		scalars := []*ecc.Scalar{g.NewScalar(), g.NewScalar().One(), orderMinusOne}
+
+		for range 16 {
+			scalars = append(scalars, g.NewScalar().Random())
+		}
+
+		// The base point products must match those of another element, here 2 * G, with half the scalar.
+		half := g.NewScalar().SetUInt64(2).Invert()
+		double := g.Base().Double()
+
+		for _, s := range scalars {
+			expected := double.Copy().Multiply(s.Copy().Multiply(half))
+
+			if !g.ScalarBaseMult(s).Equal(expected) || !g.Base().Multiply(s).Equal(expected) ||
+				!table.Multiply(s).Equal(expected) {
+				t.Fatalf("expected equality for scalar %s", s.Hex())
+			}
+		}
+	})
+}
+
 func TestElement_Arithmetic(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		elementTestEqual(t, group.group)
@@ -453,6 +1388,18 @@ func elementTestNegate(t *testing.T, g ecc.Group) {
 	if !b.Equal(negB) {
 		t.Fatal("expected equality -(-b) = b")
 	}
+
+	// -b, 0 - b, and b - 2b must have the same encoding, which differs from the encoding of b.
+	negB = g.Base().Negate()
+	for _, e := range []*ecc.Element{g.NewElement().Subtract(g.Base()), g.Base().Subtract(g.Base().Double())} {
+		if !bytes.Equal(negB.Encode(), e.Encode()) {
+			t.Fatalf("expected equal encodings of -b, got %s and %s", negB.Hex(), e.Hex())
+		}
+	}
+
+	if bytes.Equal(negB.Encode(), b.Encode()) {
+		t.Fatal("expected different encodings of b and -b")
+	}
 }
 
 func elementTestDouble(t *testing.T, g ecc.Group) {
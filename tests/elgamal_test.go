@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/0xBridge/ecc/elgamal"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestElGamal(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+		pk := g.ScalarBaseMult(sk)
+		m := g.NewScalar().Random()
+
+		ct := elgamal.Encrypt(pk, m)
+		if !elgamal.Decrypt(sk, ct).Equal(g.ScalarBaseMult(m)) {
+			t.Fatal("decryption failed")
+		}
+
+		// Encryption is randomized.
+		if ct2 := elgamal.Encrypt(pk, m); ct2.C1.Equal(ct.C1) || ct2.C2.Equal(ct.C2) {
+			t.Fatal("expected different ciphertexts")
+		}
+
+		if elgamal.Decrypt(g.NewScalar().Random(), ct).Equal(g.ScalarBaseMult(m)) {
+			t.Fatal("unexpected decryption with another key")
+		}
+	})
+}
+
+func TestElGamal_Homomorphism(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+		pk := g.ScalarBaseMult(sk)
+		table := elgamal.NewTable(g, 1000)
+
+		// Tally 0/1 votes and some larger values.
+		votes := []uint64{1, 0, 1, 1, 0, 1, 250, 300}
+		sum := uint64(0)
+		tally := elgamal.Encrypt(pk, g.NewScalar())
+
+		for _, v := range votes {
+			tally = elgamal.Add(tally, elgamal.Encrypt(pk, g.NewScalar().SetUInt64(v)))
+			sum += v
+		}
+
+		m, err := table.Log(elgamal.Decrypt(sk, tally))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m != sum {
+			t.Fatalf("expected tally %d, got %d", sum, m)
+		}
+	})
+}
+
+func TestElGamal_Table(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, bound := range []uint64{0, 1, 15, 16, 17, 100} {
+			table := elgamal.NewTable(g, bound)
+
+			for m := range bound + 1 {
+				got, err := table.Log(g.ScalarBaseMult(g.NewScalar().SetUInt64(m)))
+				if err != nil {
+					t.Fatalf("bound %d: %v", bound, err)
+				}
+
+				if got != m {
+					t.Fatalf("bound %d: expected %d, got %d", bound, m, got)
+				}
+			}
+
+			for _, m := range []uint64{bound + 1, bound + 1000} {
+				if _, err := table.Log(g.ScalarBaseMult(g.NewScalar().SetUInt64(m))); !errors.Is(err, elgamal.ErrOutOfRange) {
+					t.Fatalf("bound %d: expected out of range error for %d, got %v", bound, m, err)
+				}
+			}
+
+			if _, err := table.Log(g.NewElement().Negate().Subtract(g.Base())); !errors.Is(err, elgamal.ErrOutOfRange) {
+				t.Fatalf("bound %d: expected out of range error for -1, got %v", bound, err)
+			}
+		}
+
+		if _, err := elgamal.NewTable(g, 10).Log(nil); !errors.Is(err, internal.ErrParamNilPoint) {
+			t.Fatalf("expected nil point error, got %v", err)
+		}
+	})
+}
+
+func TestElGamal_Panics(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		pk := g.ScalarBaseMult(g.NewScalar().Random())
+
+		if err := testPanic("nil public key", internal.ErrParamNilPoint, func() {
+			_ = elgamal.Encrypt(nil, g.NewScalar())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("identity public key", internal.ErrIdentity, func() {
+			_ = elgamal.Encrypt(g.NewElement(), g.NewScalar())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil message", internal.ErrParamNilScalar, func() {
+			_ = elgamal.Encrypt(pk, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil ciphertext", internal.ErrParamNilPoint, func() {
+			_ = elgamal.Decrypt(g.NewScalar().Random(), elgamal.Ciphertext{})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil ciphertext", internal.ErrParamNilPoint, func() {
+			_ = elgamal.Add(elgamal.Encrypt(pk, g.NewScalar()), elgamal.Ciphertext{})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, bound := range []uint64{elgamal.MaxBound + 1, math.MaxUint64} {
+			if err := testPanic("bound too large", elgamal.ErrBoundTooLarge, func() {
+				_ = elgamal.NewTable(g, bound)
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
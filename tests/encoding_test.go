@@ -11,6 +11,8 @@ package ecc_test
 import (
 	"bytes"
 	"encoding"
+	"encoding/base64"
+	"encoding/gob"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -19,7 +21,9 @@ import (
 	"testing"
 
 	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/debug"
 	eccEncoding "github.com/0xBridge/ecc/encoding"
+	"github.com/0xBridge/ecc/internal"
 )
 
 type serde interface {
@@ -31,6 +35,12 @@ type serde interface {
 	UnmarshalJSON(data []byte) error
 	encoding.BinaryMarshaler
 	encoding.BinaryUnmarshaler
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	MarshalCBOR() ([]byte, error)
+	UnmarshalCBOR(data []byte) error
+	Base64() string
+	DecodeBase64(b string) error
 }
 
 type (
@@ -44,6 +54,9 @@ var encodeTesters = []makeEncodeTest{
 	binaryTest,
 	hexTest,
 	jsonTest,
+	textTest,
+	cborTest,
+	base64Test,
 }
 
 func toEncoder(s serde) byteEncoder {
@@ -107,6 +120,30 @@ func jsonTest(t *encodingTest) *encodingTest {
 	return t
 }
 
+func textTest(t *encodingTest) *encodingTest {
+	t.sourceEncoder = t.source.MarshalText
+	t.receiverDecoder = t.receiver.UnmarshalText
+	t.receiverEncoder = t.receiver.MarshalText
+
+	return t
+}
+
+func cborTest(t *encodingTest) *encodingTest {
+	t.sourceEncoder = t.source.MarshalCBOR
+	t.receiverDecoder = t.receiver.UnmarshalCBOR
+	t.receiverEncoder = t.receiver.MarshalCBOR
+
+	return t
+}
+
+func base64Test(t *encodingTest) *encodingTest {
+	t.sourceEncoder = func() ([]byte, error) { return []byte(t.source.Base64()), nil }
+	t.receiverDecoder = func(d []byte) error { return t.receiver.DecodeBase64(string(d)) }
+	t.receiverEncoder = func() ([]byte, error) { return []byte(t.receiver.Base64()), nil }
+
+	return t
+}
+
 func (t *encodingTest) run() error {
 	encoded, err := t.sourceEncoder()
 	if err != nil {
@@ -184,6 +221,245 @@ func TestElement_Encoding(t *testing.T) {
 	})
 }
 
+func TestEncoding_CBOR(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		s := group.group.NewScalar().Random()
+		e := group.group.Base().Multiply(s)
+
+		// The encoding is the array [group, encoding], and the zero values adopt the encoded group.
+		for _, v := range []struct {
+			source, receiver serde
+		}{
+			{s, &ecc.Scalar{}},
+			{e, &ecc.Element{}},
+		} {
+			encoded, err := v.source.MarshalCBOR()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// All group identifiers are below 24, and all encodings are between 24 and 255 bytes long.
+			header := []byte{0x82, byte(group.group), 0x58, byte(len(v.source.Encode()))}
+
+			if !bytes.Equal(encoded, append(header, v.source.Encode()...)) {
+				t.Fatalf("unexpected CBOR encoding %x", encoded)
+			}
+
+			if err = v.receiver.UnmarshalCBOR(encoded); err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(v.receiver.Encode(), v.source.Encode()) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			// Non-canonical length, trailing data, and truncation.
+			bad := append([]byte{0x82, byte(group.group), 0x59, 0}, encoded[3:]...)
+			for _, b := range [][]byte{bad, append(encoded, 0), encoded[:len(encoded)-1], encoded[1:]} {
+				if err = v.receiver.UnmarshalCBOR(b); err == nil {
+					t.Fatalf("expected error on %x", b)
+				}
+			}
+
+			// Unknown group.
+			unknown := bytes.Clone(encoded)
+			unknown[1] = 0
+
+			if err = v.receiver.UnmarshalCBOR(unknown); !errors.Is(err, internal.ErrInvalidGroup) {
+				t.Fatalf("expected invalid group error, got %v", err)
+			}
+		}
+
+		// Receivers of another group must not be overwritten.
+		other := ecc.P256Sha256
+		if group.group == ecc.P256Sha256 {
+			other = ecc.Ristretto255Sha512
+		}
+
+		encoded, _ := s.MarshalCBOR()
+		if err := other.NewScalar().UnmarshalCBOR(encoded); !errors.Is(err, internal.ErrCastScalar) {
+			t.Fatalf("expected group mismatch error, got %v", err)
+		}
+
+		encoded, _ = e.MarshalCBOR()
+		if err := other.NewElement().UnmarshalCBOR(encoded); !errors.Is(err, internal.ErrCastElement) {
+			t.Fatalf("expected group mismatch error, got %v", err)
+		}
+	})
+}
+
+func TestEncoding_Base64_Fails(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		s := group.group.NewScalar().Random()
+		e := group.group.Base().Multiply(s)
+
+		for _, v := range []serde{s, e} {
+			b := v.Base64()
+
+			// Padding, non URL-safe characters, and truncation to a still valid base64 string.
+			truncated := base64.RawURLEncoding.EncodeToString(v.Encode()[:len(v.Encode())-1])
+			for _, bad := range []string{b + "==", b + "+", truncated} {
+				if err := v.DecodeBase64(bad); err == nil {
+					t.Fatalf("expected error on %q", bad)
+				}
+			}
+		}
+
+		truncated := base64.RawURLEncoding.EncodeToString(s.Encode()[1:])
+		if err := s.DecodeBase64(truncated); !errors.Is(err, internal.ErrParamScalarLength) {
+			t.Fatalf("expected length error, got %v", err)
+		}
+
+		truncated = base64.RawURLEncoding.EncodeToString(e.Encode()[1:])
+		if err := e.DecodeBase64(truncated); !errors.Is(err, internal.ErrDecodingInvalidLength) {
+			t.Fatalf("expected length error, got %v", err)
+		}
+	})
+}
+
+func TestEncoding_Gob(t *testing.T) {
+	type message struct {
+		Scalar  *ecc.Scalar
+		Element *ecc.Element
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		s := group.group.NewScalar().Random()
+		in := message{Scalar: s, Element: group.group.Base().Multiply(s)}
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+			t.Fatal(err)
+		}
+
+		var out message
+		if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+			t.Fatal(err)
+		}
+
+		if out.Scalar.Group() != group.group || out.Element.Group() != group.group {
+			t.Fatal("unexpected group")
+		}
+
+		if !out.Scalar.Equal(in.Scalar) || !out.Element.Equal(in.Element) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Receivers of another group must not be overwritten.
+		other := ecc.P256Sha256
+		if group.group == ecc.P256Sha256 {
+			other = ecc.Ristretto255Sha512
+		}
+
+		encoded, _ := s.GobEncode()
+		if err := other.NewScalar().GobDecode(encoded); !errors.Is(err, internal.ErrCastScalar) {
+			t.Fatalf("expected group mismatch error, got %v", err)
+		}
+
+		encoded, _ = in.Element.GobEncode()
+		if err := other.NewElement().GobDecode(encoded); !errors.Is(err, internal.ErrCastElement) {
+			t.Fatalf("expected group mismatch error, got %v", err)
+		}
+
+		encoded[0] = 0
+		if err := new(ecc.Element).GobDecode(encoded); !errors.Is(err, internal.ErrInvalidGroup) {
+			t.Fatalf("expected invalid group error, got %v", err)
+		}
+
+		if err := new(ecc.Scalar).GobDecode(nil); err == nil {
+			t.Fatal("expected error on empty input")
+		}
+	})
+}
+
+func TestEncoding_TextMap(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalars := map[*ecc.Scalar]string{
+			g.NewScalar().Random(): "a",
+			g.NewScalar().Random(): "b",
+		}
+		elements := map[*ecc.Element]string{
+			g.Base().Multiply(g.NewScalar().Random()): "a",
+			g.Base().Multiply(g.NewScalar().Random()): "b",
+		}
+
+		// The text-based encoder uses MarshalText for map keys.
+		encS, err := json.Marshal(scalars)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encE, err := json.Marshal(elements)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decS, decE map[string]string
+		if err = json.Unmarshal(encS, &decS); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = json.Unmarshal(encE, &decE); err != nil {
+			t.Fatal(err)
+		}
+
+		for s, v := range scalars {
+			if decS[s.Hex()] != v {
+				t.Fatalf("expected key %q for scalar", s.Hex())
+			}
+		}
+
+		for e, v := range elements {
+			if decE[e.Hex()] != v {
+				t.Fatalf("expected key %q for element", e.Hex())
+			}
+		}
+
+		// Decode the keys back into a map of scalars and elements.
+		recoveredS := make(map[string]*ecc.Scalar, len(decS))
+		for k, v := range decS {
+			s := g.NewScalar()
+			if err = s.UnmarshalText([]byte(k)); err != nil {
+				t.Fatal(err)
+			}
+
+			recoveredS[v] = s
+		}
+
+		recoveredE := make(map[string]*ecc.Element, len(decE))
+		for k, v := range decE {
+			e := g.NewElement()
+			if err = e.UnmarshalText([]byte(k)); err != nil {
+				t.Fatal(err)
+			}
+
+			recoveredE[v] = e
+		}
+
+		for s, v := range scalars {
+			if !recoveredS[v].Equal(s) {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		for e, v := range elements {
+			if !recoveredE[v].Equal(e) {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// Bad text must fail.
+		if err = g.NewScalar().UnmarshalText([]byte("_")); err == nil {
+			t.Fatal("expected error on bad text")
+		}
+
+		if err = g.NewElement().UnmarshalText([]byte("_")); err == nil {
+			t.Fatal("expected error on bad text")
+		}
+	})
+}
+
 func testDecodeEmpty(t *testing.T, s serde) {
 	if err := s.Decode(nil); err == nil {
 		t.Fatal("expected error on Decode() with nil input")
@@ -265,6 +541,130 @@ func TestEncoding_Hex_Fails(t *testing.T) {
 	})
 }
 
+func TestEncoding_String(t *testing.T) {
+	names := map[ecc.Group]string{
+		ecc.Ristretto255Sha512: "Ristretto255Sha512",
+		ecc.Decaf448Shake256:   "Decaf448Shake256",
+		ecc.P256Sha256:         "P256Sha256",
+		ecc.P384Sha384:         "P384Sha384",
+		ecc.P521Sha512:         "P521Sha512",
+		ecc.Edwards25519Sha512: "Edwards25519Sha512",
+		ecc.Secp256k1Sha256:    "Secp256k1Sha256",
+		ecc.P224Sha256:         "P224Sha256",
+		ecc.Edwards448Shake256: "Edwards448Shake256",
+		ecc.BLS12381G1Sha256:   "BLS12381G1Sha256",
+		ecc.PallasSha256:       "PallasSha256",
+		ecc.VestaSha256:        "VestaSha256",
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		scalar := g.NewScalar().Random()
+		element := g.Base().Multiply(scalar)
+
+		if s := fmt.Sprintf("%v", scalar); s != names[g]+":"+scalar.Hex() {
+			t.Fatalf("unexpected scalar string %q", s)
+		}
+
+		if s := fmt.Sprint(element); s != names[g]+":"+element.Hex() {
+			t.Fatalf("unexpected element string %q", s)
+		}
+
+		if s := g.NewElement().String(); s != names[g]+":"+g.NewElement().Hex() {
+			t.Fatalf("unexpected identity string %q", s)
+		}
+	})
+}
+
+func TestEncoding_Append(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.RandomScalar()
+		e := g.RandomElement()
+		prefix := []byte("prefix")
+
+		for _, v := range []interface {
+			Encode() []byte
+			Hex() string
+			AppendBinary(b []byte) ([]byte, error)
+			AppendText(b []byte) ([]byte, error)
+		}{s, e} {
+			out, err := v.AppendBinary(bytes.Clone(prefix))
+			if err != nil || !bytes.Equal(out, append(bytes.Clone(prefix), v.Encode()...)) {
+				t.Fatalf("unexpected AppendBinary output %x, %v", out, err)
+			}
+
+			out, err = v.AppendText(bytes.Clone(prefix))
+			if err != nil || string(out) != string(prefix)+v.Hex() {
+				t.Fatalf("unexpected AppendText output %q, %v", out, err)
+			}
+		}
+	})
+}
+
+func TestEncoding_ErrorsIs(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		short := []byte{0, 1}
+
+		scalarErrors := map[string]error{
+			"Decode short":      g.NewScalar().Decode(short),
+			"Decode high":       g.NewScalar().Decode(debug.BadScalarHigh(g)),
+			"DecodeHex":         g.NewScalar().DecodeHex("zz"),
+			"DecodeBase64":      g.NewScalar().DecodeBase64("AAE"),
+			"UnmarshalText":     g.NewScalar().UnmarshalText([]byte("00")),
+			"UnmarshalBinary":   g.NewScalar().UnmarshalBinary(debug.BadScalarHigh(g)),
+			"UnmarshalJSON":     g.NewScalar().UnmarshalJSON([]byte(`"00"`)),
+			"DecodeBase64 char": g.NewScalar().DecodeBase64("!"),
+		}
+
+		for name, err := range scalarErrors {
+			if !errors.Is(err, ecc.ErrInvalidScalarEncoding) {
+				t.Errorf("scalar %s: expected ErrInvalidScalarEncoding, got %v", name, err)
+			}
+		}
+
+		if err := g.NewScalar().Decode(short); !errors.Is(err, ecc.ErrInvalidScalarLength) {
+			t.Errorf("expected ErrInvalidScalarLength, got %v", err)
+		}
+
+		if err := g.NewScalar().Decode(debug.BadScalarHigh(g)); errors.Is(err, ecc.ErrInvalidScalarLength) {
+			t.Errorf("unexpected ErrInvalidScalarLength for %v", err)
+		}
+
+		elementErrors := map[string]error{
+			"Decode short":    g.NewElement().Decode(short),
+			"Decode bad":      g.NewElement().Decode(debug.BadElementEncoding(g)),
+			"Decode identity": g.NewElement().Decode(g.NewElement().Encode()),
+			"DecodeHex":       g.NewElement().DecodeHex("zz"),
+			"DecodeBase64":    g.NewElement().DecodeBase64("AAE"),
+			"UnmarshalText":   g.NewElement().UnmarshalText([]byte("00")),
+			"UnmarshalBinary": g.NewElement().UnmarshalBinary(debug.BadElementEncoding(g)),
+			"UnmarshalJSON":   g.NewElement().UnmarshalJSON([]byte(`"00"`)),
+		}
+
+		for name, err := range elementErrors {
+			if !errors.Is(err, ecc.ErrInvalidPointEncoding) {
+				t.Errorf("element %s: expected ErrInvalidPointEncoding, got %v", name, err)
+			}
+		}
+
+		other := ecc.Ristretto255Sha512
+		if g == other {
+			other = ecc.P256Sha256
+		}
+
+		defer func() {
+			r := recover()
+			if err, ok := r.(error); !ok || !errors.Is(err, ecc.ErrWrongGroup) {
+				t.Errorf("expected a panic with ErrWrongGroup, got %v", r)
+			}
+		}()
+
+		g.Base().Add(other.Base())
+	})
+}
+
 func TestJSONReGetGroup(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		test := struct {
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/0xBridge/hash2curve"
+	"github.com/bytemare/hash"
+
+	"github.com/0xBridge/ecc"
+)
+
+// expandMessageVectorsFileLocation holds the expand_message_xmd and expand_message_xof test vectors of RFC9380
+// Appendix K, for the expanders used by the hash-to-curve suites. Some of them use DSTs longer than 255 bytes.
+const expandMessageVectorsFileLocation = "expand"
+
+// oversizeDSTPrefix is the prefix to DSTs longer than 255 bytes that are hashed to a shorter one, per RFC9380.
+const oversizeDSTPrefix = "H2C-OVERSIZE-DST-"
+
+type expandVectors struct {
+	DST   string         `json:"DST"`
+	Hash  string         `json:"hash"`
+	Name  string         `json:"name"`
+	Tests []expandVector `json:"tests"`
+}
+
+type expandVector struct {
+	LenInBytes   string `json:"len_in_bytes"`
+	Msg          string `json:"msg"`
+	UniformBytes string `json:"uniform_bytes"`
+}
+
+func (v *expandVectors) expand(t *testing.T, msg []byte, length uint) []byte {
+	switch v.Name {
+	case "expand_message_xmd":
+		hashes := map[string]crypto.Hash{
+			"SHA256": crypto.SHA256,
+			"SHA512": crypto.SHA512,
+		}
+
+		h, ok := hashes[v.Hash]
+		if !ok {
+			t.Fatalf("unsupported hash function %q", v.Hash)
+		}
+
+		uniform := hash2curve.ExpandXMD(h, msg, []byte(v.DST), length)
+		if !bytes.Equal(ecc.ExpandMessage(h, msg, []byte(v.DST), int(length)), uniform) {
+			t.Fatal("expected ExpandMessage to match the expander")
+		}
+
+		return uniform
+	case "expand_message_xof":
+		xofs := map[string]hash.Hash{
+			"SHAKE128": hash.SHAKE128,
+			"SHAKE256": hash.SHAKE256,
+		}
+
+		xof, ok := xofs[v.Hash]
+		if !ok {
+			t.Fatalf("unsupported XOF %q", v.Hash)
+		}
+
+		uniform := hash2curve.ExpandXOF(xof.GetXOF(), msg, []byte(v.DST), length)
+		if !bytes.Equal(ecc.ExpandMessageXOF(xof, msg, []byte(v.DST), int(length)), uniform) {
+			t.Fatal("expected ExpandMessageXOF to match the expander")
+		}
+
+		return uniform
+	default:
+		t.Fatalf("unsupported expander %q", v.Name)
+		return nil
+	}
+}
+
+func TestExpandMessageVectors(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(expandMessageVectorsFileLocation, "*.json"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no vector files found: %v", err)
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			val, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var v expandVectors
+			if err = json.Unmarshal(val, &v); err != nil {
+				t.Fatal(err)
+			}
+
+			for i, test := range v.Tests {
+				length, err := strconv.ParseUint(test.LenInBytes, 0, 32)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				uniform := v.expand(t, []byte(test.Msg), uint(length))
+				if hex.EncodeToString(uniform) != test.UniformBytes {
+					t.Fatalf("%d: unexpected uniform bytes.\n\tExpected %q\n\tgot %q", i, test.UniformBytes,
+						hex.EncodeToString(uniform))
+				}
+			}
+		})
+	}
+}
+
+// oversizeDST returns the DST RFC9380 prescribes in place of dst, longer than 255 bytes, for the group's expander.
+func oversizeDST(g ecc.Group, dst []byte) []byte {
+	switch g {
+	case ecc.Decaf448Shake256, ecc.Edwards448Shake256:
+		// The suites target a security level of k = 224 bits, so the tag is 2 * k / 8 bytes long.
+		xof := hash.SHAKE256.GetXOF()
+		xof.SetOutputSize(2 * 224 / 8)
+
+		return xof.Hash([]byte(oversizeDSTPrefix), dst)
+	default:
+		h := g.HashFunc().New()
+		_, _ = h.Write([]byte(oversizeDSTPrefix))
+		_, _ = h.Write(dst)
+
+		return h.Sum(nil)
+	}
+}
+
+func TestOversizeDST(t *testing.T) {
+	input := []byte("input data")
+	dst := make([]byte, 300)
+
+	for i := range dst {
+		dst[i] = byte('a' + i%26)
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		short := oversizeDST(g, dst)
+
+		if !g.HashToScalar(input, dst).Equal(g.HashToScalar(input, short)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.HashToGroup(input, dst).Equal(g.HashToGroup(input, short)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.EncodeToGroup(input, dst).Equal(g.EncodeToGroup(input, short)) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestExpandMessage_Panics(t *testing.T) {
+	dst := []byte("dst")
+
+	for _, f := range []func(){
+		func() { _ = ecc.ExpandMessage(crypto.SHA256, nil, dst, 0) },
+		func() { _ = ecc.ExpandMessage(crypto.SHA256, nil, dst, 255*32+1) },
+		func() { _ = ecc.ExpandMessageXOF(hash.SHAKE256, nil, dst, 0) },
+		func() { _ = ecc.ExpandMessageXOF(hash.SHAKE256, nil, dst, 1<<16) },
+		func() { _ = ecc.ExpandMessageXOF(hash.SHA256, nil, dst, 32) },
+		func() { _ = ecc.ExpandMessage(crypto.SHA256, nil, nil, 32) },
+	} {
+		if err := testPanic("invalid expand_message parameters", nil, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(ecc.ExpandMessage(crypto.SHA512, nil, dst, 255*64)) != 255*64 {
+		t.Fatal("unexpected output length")
+	}
+}
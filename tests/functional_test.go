@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestFunctional_Scalars(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+		aCopy, bCopy := a.Copy(), b.Copy()
+
+		for _, test := range []struct {
+			f        func(a, b *ecc.Scalar) *ecc.Scalar
+			expected *ecc.Scalar
+		}{
+			{ecc.AddScalars, a.Copy().Add(b)},
+			{ecc.SubScalars, a.Copy().Subtract(b)},
+			{ecc.MulScalars, a.Copy().Multiply(b)},
+		} {
+			res := test.f(a, b)
+			if !res.Equal(test.expected) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if res == a || res == b {
+				t.Fatal("expected a freshly allocated result")
+			}
+
+			if !a.Equal(aCopy) || !b.Equal(bCopy) {
+				t.Fatal("inputs must not be modified")
+			}
+
+			if err := testPanic("nil scalar", internal.ErrParamNilScalar, func() { test.f(nil, b) }); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := testPanic("nil scalar", internal.ErrParamNilScalar, func() { test.f(a, nil) }); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestFunctional_Elements(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.NewScalar().Random()
+		a, b := g.Base().Multiply(g.NewScalar().Random()), g.Base().Multiply(g.NewScalar().Random())
+		aCopy, bCopy := a.Copy(), b.Copy()
+
+		for _, test := range []struct {
+			f        func(a, b *ecc.Element) *ecc.Element
+			expected *ecc.Element
+		}{
+			{ecc.AddElements, a.Copy().Add(b)},
+			{ecc.SubElements, a.Copy().Subtract(b)},
+		} {
+			res := test.f(a, b)
+			if !res.Equal(test.expected) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if res == a || res == b {
+				t.Fatal("expected a freshly allocated result")
+			}
+
+			if !a.Equal(aCopy) || !b.Equal(bCopy) {
+				t.Fatal("inputs must not be modified")
+			}
+
+			if err := testPanic("nil element", internal.ErrParamNilPoint, func() { test.f(nil, b) }); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := testPanic("nil element", internal.ErrParamNilPoint, func() { test.f(a, nil) }); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		sCopy := s.Copy()
+
+		for _, scalarMult := range []func(*ecc.Element, *ecc.Scalar) *ecc.Element{ecc.ScalarMult, ecc.ScalarMultNew} {
+			res := scalarMult(a, s)
+			if !res.Equal(a.Copy().Multiply(s)) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			if res == a || !a.Equal(aCopy) || !s.Equal(sCopy) {
+				t.Fatal("inputs must not be modified")
+			}
+
+			if err := testPanic("nil element", internal.ErrParamNilPoint, func() { scalarMult(nil, s) }); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := testPanic("nil scalar", internal.ErrParamNilScalar, func() { scalarMult(a, nil) }); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		res := ecc.NegateElement(a)
+		if !res.Equal(a.Copy().Negate()) || !res.Add(a).IsIdentity() {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !a.Equal(aCopy) {
+			t.Fatal("inputs must not be modified")
+		}
+
+		if err := testPanic("nil element", internal.ErrParamNilPoint, func() { ecc.NegateElement(nil) }); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestFunctional_WrongGroup(t *testing.T) {
+	a := ecc.Ristretto255Sha512.NewScalar().Random()
+	b := ecc.P256Sha256.NewScalar().Random()
+
+	if err := testPanic("wrong group", internal.ErrCastScalar, func() { ecc.AddScalars(a, b) }); err != nil {
+		t.Fatal(err)
+	}
+
+	e := ecc.Ristretto255Sha512.Base()
+	f := ecc.P256Sha256.Base()
+
+	if err := testPanic("wrong group", internal.ErrCastElement, func() { ecc.AddElements(e, f) }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testPanic("wrong group", internal.ErrCastElement, func() { ecc.SubElements(e, f) }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testPanic("wrong group", internal.ErrCastScalar, func() { ecc.ScalarMult(e, b) }); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testPanic("wrong group", internal.ErrCastScalar, func() { ecc.ScalarMultNew(e, b) }); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,8 +9,13 @@
 package ecc_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/0xBridge/ecc"
@@ -33,17 +38,7 @@ func TestNonAvailability(t *testing.T) {
 		t.Errorf(consideredAvailableFmt, oob)
 	}
 
-	d := ecc.Group(2) // decaf448
-	if d.Available() {
-		t.Errorf(consideredAvailableFmt, d)
-	}
-
-	if err := testPanic("decaf availability", internal.ErrInvalidGroup,
-		func() { _ = d.String() }); err != nil {
-		t.Fatal(err)
-	}
-
-	oob = ecc.Secp256k1Sha256 + 1
+	oob = ecc.VestaSha256 + 1
 	if oob.Available() {
 		t.Errorf(consideredAvailableFmt, oob)
 	}
@@ -70,16 +65,72 @@ func TestGroup_Base(t *testing.T) {
 	})
 }
 
+func TestGroup_ScalarBaseMult(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if !g.ScalarBaseMult(nil).IsIdentity() || !g.ScalarBaseMult(g.NewScalar()).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !g.ScalarBaseMult(g.NewScalar().One()).Equal(g.Base()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		scalars := []*ecc.Scalar{
+			g.NewScalar().SetUInt64(2),
+			g.NewScalar().SetUInt64(15),
+			g.NewScalar().SetUInt64(16),
+			g.NewScalar().MinusOne(),
+		}
+
+		for range 32 {
+			scalars = append(scalars, g.NewScalar().Random())
+		}
+
+		for _, s := range scalars {
+			if !g.ScalarBaseMult(s).Equal(g.Base().Multiply(s)) {
+				t.Fatalf("unexpected result for %s", s.Hex())
+			}
+		}
+
+		// The results must be independent.
+		a, b := g.ScalarBaseMult(scalars[0]), g.ScalarBaseMult(scalars[0])
+		a.Double()
+
+		if a.Equal(b) || !b.Equal(g.Base().Double()) {
+			t.Fatal("unexpected shared state between results")
+		}
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			_ = g.ScalarBaseMult(wrongGroup.NewScalar().Random())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func TestDST(t *testing.T) {
 	app := "app"
 	version := uint8(1)
 	tests := map[ecc.Group]string{
 		ecc.Ristretto255Sha512: app + "-V01-CS01-",
+		ecc.Decaf448Shake256:   app + "-V01-CS02-",
 		ecc.P256Sha256:         app + "-V01-CS03-",
 		ecc.P384Sha384:         app + "-V01-CS04-",
 		ecc.P521Sha512:         app + "-V01-CS05-",
 		ecc.Edwards25519Sha512: app + "-V01-CS06-",
 		ecc.Secp256k1Sha256:    app + "-V01-CS07-",
+		ecc.P224Sha256:         app + "-V01-CS08-",
+		ecc.Edwards448Shake256: app + "-V01-CS09-",
+		ecc.BLS12381G1Sha256:   app + "-V01-CS10-",
+		ecc.PallasSha256:       app + "-V01-CS11-",
+		ecc.VestaSha256:        app + "-V01-CS12-",
 	}
 
 	testAllGroups(t, func(group *testGroup) {
@@ -91,6 +142,104 @@ func TestDST(t *testing.T) {
 	})
 }
 
+func TestGroup_HashWithDST(t *testing.T) {
+	app := "app"
+	version := uint8(1)
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		dst := g.MakeDST(app, version)
+
+		if !g.HashToGroupDST(testHashToGroupInput, app, version).Equal(g.HashToGroup(testHashToGroupInput, dst)) {
+			t.Fatal("expected HashToGroupDST to match HashToGroup with MakeDST")
+		}
+
+		if !g.HashToScalarDST(testHashToGroupInput, app, version).Equal(g.HashToScalar(testHashToGroupInput, dst)) {
+			t.Fatal("expected HashToScalarDST to match HashToScalar with MakeDST")
+		}
+
+		// Another version must yield another mapping.
+		if g.HashToGroupDST(testHashToGroupInput, app, version+1).Equal(g.HashToGroup(testHashToGroupInput, dst)) {
+			t.Fatal("expected different elements for different versions")
+		}
+	})
+}
+
+func TestGroup_BatchHashToGroup(t *testing.T) {
+	inputs := [][]byte{testHashToGroupInput, nil, []byte("another input"), testHashToGroupInput}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		out := g.BatchHashToGroup(inputs, testHashToGroupDST)
+		if len(out) != len(inputs) {
+			t.Fatalf("expected %d elements, got %d", len(inputs), len(out))
+		}
+
+		for i, input := range inputs {
+			if !out[i].Equal(g.HashToGroup(input, testHashToGroupDST)) {
+				t.Fatalf("expected BatchHashToGroup to match HashToGroup for input %d", i)
+			}
+		}
+
+		if len(g.BatchHashToGroup(nil, testHashToGroupDST)) != 0 {
+			t.Fatal("expected no elements for no inputs")
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_ = g.BatchHashToGroup(inputs, nil)
+		}); err != nil {
+			t.Error(fmt.Errorf(errWrapGroup, errNoPanic, err))
+		}
+	})
+}
+
+func TestGroup_HashToGenerators(t *testing.T) {
+	n := 16
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		generators := g.HashToGenerators(n, testHashToGroupDST)
+
+		if len(generators) != n {
+			t.Fatalf("expected %d generators, got %d", n, len(generators))
+		}
+
+		seen := map[string]bool{string(g.Base().Encode()): true}
+
+		for i, e := range generators {
+			if e.IsIdentity() {
+				t.Fatalf("unexpected identity generator %d", i)
+			}
+
+			if seen[string(e.Encode())] {
+				t.Fatalf("expected generator %d to be distinct from the base point and the other generators", i)
+			}
+
+			seen[string(e.Encode())] = true
+		}
+
+		// Generators are deterministic, and a shorter list is a prefix of a longer one.
+		again := g.HashToGenerators(n/2, testHashToGroupDST)
+		for i, e := range again {
+			if !e.Equal(generators[i]) {
+				t.Fatalf("expected generator %d to be deterministic", i)
+			}
+		}
+
+		// Another DST yields other generators.
+		if g.HashToGenerators(1, []byte("another domain separation tag"))[0].Equal(generators[0]) {
+			t.Fatal("expected different generators for different DSTs")
+		}
+
+		if err := testPanic("zero count", errNonPositiveCount, func() {
+			_ = g.HashToGenerators(0, testHashToGroupDST)
+		}); err != nil {
+			t.Error(fmt.Errorf(errWrapGroup, errNoPanic, err))
+		}
+	})
+}
+
 func TestGroup_String(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		res := group.group.String()
@@ -101,6 +250,58 @@ func TestGroup_String(t *testing.T) {
 	})
 }
 
+func TestSupportedCiphersuites(t *testing.T) {
+	suites := ecc.SupportedCiphersuites()
+	if len(suites) != len(testTable) {
+		t.Fatalf("expected %d ciphersuites, got %d", len(testTable), len(suites))
+	}
+
+	// GroupFromString maps a ciphersuite identifier back to its group, so every returned identifier must round-trip
+	// through it.
+	for _, suite := range suites {
+		g, err := ecc.GroupFromString(suite)
+		if err != nil {
+			t.Fatalf("ciphersuite %q: %v", suite, err)
+		}
+
+		if !g.Available() || g.String() != suite {
+			t.Fatalf("ciphersuite %q does not map to an available group", suite)
+		}
+
+		found := false
+
+		for _, group := range testTable {
+			if group.group == g && group.h2c == suite {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Fatalf("unexpected ciphersuite %q", suite)
+		}
+	}
+}
+
+func TestGroupFromString(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g, err := ecc.GroupFromString(group.h2c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if g != group.group {
+			t.Fatalf("expected %v, got %v", group.group, g)
+		}
+	})
+
+	for _, suite := range []string{"", "ristretto255", "P256_XMD:SHA-256_SSWU_NU_", "p256_XMD:SHA-256_SSWU_RO_"} {
+		if _, err := ecc.GroupFromString(suite); err == nil {
+			t.Fatalf("expected an error for ciphersuite %q", suite)
+		}
+	}
+}
+
 func TestGroup_NewScalar(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		s := group.group.NewScalar().Encode()
@@ -123,6 +324,55 @@ func TestGroup_NewElement(t *testing.T) {
 	})
 }
 
+func TestGroup_Random(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s1, s2 := g.RandomScalar(), g.RandomScalar()
+
+		if s1.IsZero() || s1.Group() != g || s1.Equal(s2) {
+			t.Fatal("expected distinct non-zero random scalars")
+		}
+
+		e1, e2 := g.RandomElement(), g.RandomElement()
+
+		if e1.IsIdentity() || !e1.IsValid() || e1.Group() != g || e1.Equal(e2) {
+			t.Fatal("expected distinct valid random elements")
+		}
+	})
+}
+
+func TestGroup_FromConstructors(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if !g.NewScalarFromUint64(42).Equal(g.NewScalar().SetUInt64(42)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		s := g.RandomScalar()
+		decodedScalar, err := g.ScalarFromHex(s.Hex())
+		if err != nil || !decodedScalar.Equal(s) {
+			t.Fatalf("unexpected scalar decoding: %v", err)
+		}
+
+		e := g.RandomElement()
+		decodedElement, err := g.ElementFromHex(e.Hex())
+		if err != nil || !decodedElement.Equal(e) {
+			t.Fatalf("unexpected element decoding: %v", err)
+		}
+
+		if _, err = g.ScalarFromHex("zz"); !errors.Is(err, ecc.ErrInvalidScalarEncoding) ||
+			!strings.HasPrefix(err.Error(), "ScalarFromHex: ") {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		if _, err = g.ElementFromHex(g.NewElement().Hex()); !errors.Is(err, ecc.ErrInvalidPointEncoding) ||
+			!strings.HasPrefix(err.Error(), "ElementFromHex: ") {
+			t.Fatalf("unexpected error %v", err)
+		}
+	})
+}
+
 func TestGroup_ScalarLength(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		if int(group.group.ScalarLength()) != group.scalarLength {
@@ -158,6 +408,70 @@ func TestHashToScalar(t *testing.T) {
 	})
 }
 
+func TestHashToScalars(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		input, dst := group.hashToCurve.input, group.hashToCurve.dst
+		s := group.group.HashToScalars(input, dst, 3)
+
+		if len(s) != 3 {
+			t.Fatalf("unexpected number of scalars %d", len(s))
+		}
+
+		// Deterministic, and independent of each other.
+		for i, si := range group.group.HashToScalars(input, dst, 3) {
+			if !si.Equal(s[i]) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			for _, sj := range s[:i] {
+				if si.Equal(sj) {
+					t.Fatal("unexpected equal scalars")
+				}
+			}
+		}
+
+		// A single output is HashToScalar, and the output length is bound to the expansion.
+		one := group.group.HashToScalars(input, dst, 1)[0]
+		if !one.Equal(group.group.HashToScalar(input, dst)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if one.Equal(s[0]) {
+			t.Fatal("unexpected equal scalars for different counts")
+		}
+
+		if err := testPanic("zero count", errNonPositiveCount, func() {
+			_ = group.group.HashToScalars(input, dst, 0)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestHashToScalars_Vectors(t *testing.T) {
+	vectors := map[ecc.Group][]string{
+		ecc.Ristretto255Sha512: {
+			"4a62219820147abec5caf185c144fb31303f24d3ec5145c6d3fcb28b11fdb601",
+			"d9f3840a3668d01ee1e0ea3a977cdf8bcad98242a0d51e06a956eec709bf8f01",
+			"4d154e6a7f4888bc952a442794a954fb82cd3135b5ee0af63f66650fdf863b09",
+		},
+		ecc.P256Sha256: {
+			"1e9cc6c0685933851bfff69829ae4923e506077b6c17cdb99d3c545ed8dd253d",
+			"8d3b423d8e4806107848d5b02e65f7d4d4cbb5d150e6417ad611bdafdd18dbe6",
+			"135856a0c2240c5f6812f045e13193e11872db6386302e29bd3ff57816a72916",
+		},
+	}
+
+	for g, expected := range vectors {
+		s := g.HashToScalars(testHashToGroupInput, testHashToGroupDST, len(expected))
+		for i, e := range expected {
+			if s[i].Hex() != e {
+				t.Fatalf("%s: unexpected scalar %d.\n\tExpected %q\n\tgot %q", g, i, e, s[i].Hex())
+			}
+		}
+	}
+}
+
 func TestHashToScalar_NoDST(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		data := []byte("input data")
@@ -189,6 +503,20 @@ func TestHashToGroup(t *testing.T) {
 	})
 }
 
+func TestEncodeToGroup_NonUniform(t *testing.T) {
+	input, dst := []byte("input data"), []byte("domain separation tag")
+
+	testAllGroups(t, func(group *testGroup) {
+		e := group.group.EncodeToGroup(input, dst)
+		h := group.group.HashToGroup(input, dst)
+
+		// Only the groups without a _NU_ suite alias HashToGroup.
+		if (group.h2c == group.e2c) != e.Equal(h) {
+			t.Fatalf("unexpected EncodeToGroup output for suite %s", group.e2c)
+		}
+	})
+}
+
 func TestHashToGroup_NoDST(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		data := []byte("input data")
@@ -217,3 +545,110 @@ func TestGroup_Order(t *testing.T) {
 		}
 	})
 }
+
+func TestGroup_OrderBigInt(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		order := group.group.OrderBigInt()
+
+		// order - 1 must be the scalar -1.
+		s := group.group.NewScalar().SetBytesReduced(order.Sub(order, big.NewInt(1)).Bytes())
+		if !s.Equal(group.group.NewScalar().MinusOne()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The returned value must not alias the group's.
+		if group.group.OrderBigInt().Cmp(order) == 0 {
+			t.Fatal("unexpected aliasing of the group order")
+		}
+	})
+}
+
+func TestGroup_FieldPrime(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		expected, _ := new(big.Int).SetString(group.fieldOrder, 10)
+		p := group.group.FieldPrime()
+
+		if len(p) != (expected.BitLen()+7)/8 {
+			t.Fatalf("unexpected field prime length %d", len(p))
+		}
+
+		if new(big.Int).SetBytes(p).Cmp(expected) != 0 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestGroup_HashToField(t *testing.T) {
+	input, dst := []byte("input"), []byte("domain separation tag")
+
+	testAllGroups(t, func(group *testGroup) {
+		p := new(big.Int).SetBytes(group.group.FieldPrime())
+		u := group.group.HashToField(input, dst, 3)
+
+		if len(u) != 3 {
+			t.Fatalf("unexpected number of field elements %d", len(u))
+		}
+
+		for _, e := range u {
+			if len(e) != len(group.group.FieldPrime()) || new(big.Int).SetBytes(e).Cmp(p) >= 0 {
+				t.Fatalf("invalid field element %x", e)
+			}
+		}
+
+		if err := testPanic("zero count", errNonPositiveCount, func() {
+			_ = group.group.HashToField(input, dst, 0)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// Ristretto255 and Decaf448 use the hash_to_field parameters of their underlying curves.
+	for g, curve := range map[ecc.Group]ecc.Group{
+		ecc.Ristretto255Sha512: ecc.Edwards25519Sha512,
+		ecc.Decaf448Shake256:   ecc.Edwards448Shake256,
+	} {
+		if !slices.EqualFunc(g.HashToField(input, dst, 2), curve.HashToField(input, dst, 2), bytes.Equal) {
+			t.Fatalf("%s: %v", g, errExpectedEquality)
+		}
+	}
+}
+
+func TestGroup_Cofactor(t *testing.T) {
+	blsCofactor, _ := new(big.Int).SetString(bls12381G1Cofactor, 16)
+	cofactors := map[ecc.Group]*big.Int{
+		ecc.Ristretto255Sha512: big.NewInt(1),
+		ecc.Decaf448Shake256:   big.NewInt(1),
+		ecc.P256Sha256:         big.NewInt(1),
+		ecc.P384Sha384:         big.NewInt(1),
+		ecc.P521Sha512:         big.NewInt(1),
+		ecc.Edwards25519Sha512: big.NewInt(8),
+		ecc.Secp256k1Sha256:    big.NewInt(1),
+		ecc.P224Sha256:         big.NewInt(1),
+		ecc.Edwards448Shake256: big.NewInt(4),
+		ecc.BLS12381G1Sha256:   blsCofactor,
+		ecc.PallasSha256:       big.NewInt(1),
+		ecc.VestaSha256:        big.NewInt(1),
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		expected, ok := cofactors[group.group]
+		if !ok {
+			t.Fatalf("no expected cofactor for group %s", group.name)
+		}
+
+		h := group.group.Cofactor()
+		if len(h) != group.group.ScalarLength() {
+			t.Fatalf("unexpected cofactor length %d", len(h))
+		}
+
+		// The cofactor is encoded as a scalar, and is smaller than the order.
+		s := group.group.NewScalar()
+		if err := s.Decode(h); err != nil {
+			t.Fatal(err)
+		}
+
+		if scalarToBigInt(group.group, s).Cmp(expected) != 0 {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
@@ -23,9 +23,13 @@ import (
 	"filippo.io/edwards25519/field"
 
 	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
 	edwards255192 "github.com/0xBridge/ecc/internal/edwards25519"
 )
 
+// hashToCurveVectorsFileLocation holds the hash-to-curve test vectors. Those of RFC9380 are used where the RFC defines
+// the suite. The pallas and vesta vectors were generated with this package's own implementation, since no independent
+// implementation of these SHA-256 suites is known, and so only guard against regressions rather than prove correctness.
 const hashToCurveVectorsFileLocation = "h2c"
 
 type h2cVectors struct {
@@ -105,6 +109,42 @@ func vectorToEdwards25519(t *testing.T, x, y string) *edwards25519.Point {
 	return edwards255192.AffineToEdwards(u, v)
 }
 
+func vectorToEdwards448(x, y string) []byte {
+	xb, yb := vectorToBig(x, y)
+
+	// RFC 8032 encoding: the little-endian y coordinate, with the sign of x in the most significant bit.
+	output := internal.Reverse(yb.FillBytes(make([]byte, 57)))
+	output[56] |= byte(xb.Bit(0)) << 7
+
+	return output
+}
+
+func vectorToBLS12381G1(x, y string) []byte {
+	xb, yb := vectorToBig(x, y)
+	p, _ := new(big.Int).SetString("1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", 16)
+
+	// ZCash compressed encoding: the big-endian x coordinate, with the compression flag, and the sign flag set if y is
+	// larger than -y.
+	output := xb.FillBytes(make([]byte, 48))
+	output[0] |= 0x80
+
+	if yb.Cmp(new(big.Int).Sub(p, yb)) > 0 {
+		output[0] |= 0x20
+	}
+
+	return output
+}
+
+func vectorToPasta(x, y string) []byte {
+	xb, yb := vectorToBig(x, y)
+
+	// The little-endian x coordinate, with the parity of y in the most significant bit.
+	output := internal.Reverse(xb.FillBytes(make([]byte, 32)))
+	output[31] |= byte(yb.Bit(0)) << 7
+
+	return output
+}
+
 func vectorToSecp256k1(x, y string) []byte {
 	var output [33]byte
 
@@ -131,6 +171,19 @@ func (v *h2cVector) run(t *testing.T) {
 		expected = hex.EncodeToString(p.Bytes())
 	case ecc.Secp256k1Sha256:
 		expected = hex.EncodeToString(vectorToSecp256k1(v.P.X, v.P.Y))
+	case ecc.Edwards448Shake256:
+		expected = hex.EncodeToString(vectorToEdwards448(v.P.X, v.P.Y))
+	case ecc.BLS12381G1Sha256:
+		expected = hex.EncodeToString(vectorToBLS12381G1(v.P.X, v.P.Y))
+	case ecc.PallasSha256, ecc.VestaSha256:
+		expected = hex.EncodeToString(vectorToPasta(v.P.X, v.P.Y))
+	}
+
+	u := v.group.HashToField([]byte(v.Msg), []byte(v.Dst), len(v.U))
+	for i, ui := range v.U {
+		if err := verifyFieldElement(u[i], ui); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	switch v.Ciphersuite[len(v.Ciphersuite)-3:] {
@@ -161,6 +214,19 @@ func verifyEncoding(p *ecc.Element, function, expected string) error {
 	return nil
 }
 
+func verifyFieldElement(u []byte, expected string) error {
+	e, ok := new(big.Int).SetString(expected, 0)
+	if !ok {
+		return fmt.Errorf("invalid field element %q", expected)
+	}
+
+	if new(big.Int).SetBytes(u).Cmp(e) != 0 {
+		return fmt.Errorf("Unexpected HashToField output.\n\tExpected %q\n\tgot %q", expected, hex.EncodeToString(u))
+	}
+
+	return nil
+}
+
 func (v *h2cVectors) runCiphersuite(t *testing.T) {
 	for _, vector := range v.Vectors {
 		vector.h2cVectors = v
@@ -69,7 +69,7 @@ func jsonTester(badJSONErr string, in any) error {
 	// UnmarshallJSON: bad group
 	baddie = jsonTesterBaddie{
 		key:           "\"group\"",
-		value:         "\"group\":2, \"oldGroup\"",
+		value:         "\"group\":0, \"oldGroup\"",
 		expectedError: internal.ErrInvalidGroup.Error(),
 	}
 
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+func TestDeriveSharedKey(t *testing.T) {
+	salt := []byte("salt")
+	info := []byte("info")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+		pubA, pubB := g.Base().Multiply(a), g.Base().Multiply(b)
+
+		keyA, err := g.DeriveSharedKey(a, pubB, salt, info, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		keyB, err := g.DeriveSharedKey(b, pubA, salt, info, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(keyA) != 32 || !bytes.Equal(keyA, keyB) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// The input keying material is the canonical encoding of the shared element.
+		expected := make([]byte, 32)
+		ikm := pubB.Copy().Multiply(a).Encode()
+		if _, err = io.ReadFull(hkdf.New(g.HashFunc().New, ikm, salt, info), expected); err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(keyA, expected) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Different info yields different keys.
+		other, err := g.DeriveSharedKey(a, pubB, salt, []byte("other info"), 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Equal(keyA, other) {
+			t.Fatal(errUnExpectedEquality)
+		}
+
+		// The peer element must not be modified.
+		if !pubB.Equal(g.Base().Multiply(b)) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestDeriveSharedKey_Fails(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.NewScalar().Random()
+		peer := g.Base().Multiply(g.NewScalar().Random())
+
+		if _, err := g.DeriveSharedKey(nil, peer, nil, nil, 32); err == nil {
+			t.Fatal("expected error on nil secret")
+		}
+
+		if _, err := g.DeriveSharedKey(g.NewScalar(), peer, nil, nil, 32); err == nil {
+			t.Fatal("expected error on zero secret")
+		}
+
+		if _, err := g.DeriveSharedKey(s, nil, nil, nil, 32); err == nil {
+			t.Fatal("expected error on nil peer")
+		}
+
+		if _, err := g.DeriveSharedKey(s, g.NewElement(), nil, nil, 32); err == nil {
+			t.Fatal("expected error on identity peer")
+		}
+
+		if _, err := g.DeriveSharedKey(s, peer, nil, nil, 0); err == nil {
+			t.Fatal("expected error on zero length")
+		}
+
+		if _, err := g.DeriveSharedKey(s, peer, nil, nil, 255*g.HashFunc().Size()+1); err == nil {
+			t.Fatal("expected error on too long length")
+		}
+	})
+}
+
+func TestDeriveScalar(t *testing.T) {
+	ikm := []byte("master secret")
+	salt := []byte("salt")
+	info := []byte("signing key")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		s := g.DeriveScalar(ikm, salt, info)
+
+		if s.IsZero() || !s.Equal(g.DeriveScalar(ikm, salt, info)) {
+			t.Fatal("expected the same non-zero scalar for the same inputs")
+		}
+
+		// The scalar is the reduction of ScalarLength + 16 bytes of HKDF output.
+		uniform := make([]byte, g.ScalarLength()+16)
+		if _, err := io.ReadFull(hkdf.New(g.HashFunc().New, ikm, salt, info), uniform); err != nil {
+			t.Fatal(err)
+		}
+
+		if !s.Equal(g.NewScalar().SetBigInt(new(big.Int).SetBytes(uniform))) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Any other input yields another scalar.
+		if s.Equal(g.DeriveScalar([]byte("other secret"), salt, info)) ||
+			s.Equal(g.DeriveScalar(ikm, []byte("other salt"), info)) ||
+			s.Equal(g.DeriveScalar(ikm, salt, []byte("other info"))) {
+			t.Fatal(errUnExpectedEquality)
+		}
+	})
+}
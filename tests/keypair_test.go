@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+)
+
+func TestKeyPair(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		kp := g.NewKeyPair()
+
+		if kp.Group() != g || kp.Secret().IsZero() {
+			t.Fatal("expected a non-zero secret in the group")
+		}
+
+		if !kp.Public().Equal(g.Base().Multiply(kp.Secret())) {
+			t.Fatal("expected the public element to be the secret times the base point")
+		}
+
+		// The accessors return copies.
+		kp.Secret().Zero()
+		kp.Public().Identity()
+
+		if kp.Secret().IsZero() || kp.Public().IsIdentity() {
+			t.Fatal("unexpected modification of the key pair")
+		}
+	})
+}
+
+func TestKeyPairFromSeed(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		seed := bytes.Repeat([]byte{7}, g.ScalarLength())
+
+		kp1, err := g.KeyPairFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		kp2, err := g.KeyPairFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !kp1.Secret().Equal(kp2.Secret()) || !kp1.Public().Equal(kp2.Public()) {
+			t.Fatal("expected the same key pair for the same seed")
+		}
+
+		if !kp1.Public().Equal(g.Base().Multiply(kp1.Secret())) {
+			t.Fatal("expected the public element to be the secret times the base point")
+		}
+
+		seed[0] ^= 1
+
+		kp3, err := g.KeyPairFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if kp3.Secret().Equal(kp1.Secret()) {
+			t.Fatal("expected different key pairs for different seeds")
+		}
+
+		if _, err = g.KeyPairFromSeed(seed[:g.ScalarLength()-1]); err == nil {
+			t.Fatal("expected an error for a short seed")
+		}
+	})
+}
+
+func TestKeyPair_Encoding(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		kp := g.NewKeyPair()
+
+		b, err := kp.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := new(ecc.KeyPair)
+		if err = decoded.UnmarshalBinary(b); err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Secret().Equal(kp.Secret()) || !decoded.Public().Equal(kp.Public()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		j, err := json.Marshal(kp)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded = new(ecc.KeyPair)
+		if err = json.Unmarshal(j, decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Secret().Equal(kp.Secret()) || !decoded.Public().Equal(kp.Public()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// A public element that doesn't match the secret must be rejected.
+		other := g.NewKeyPair().Public().Hex()
+		tampered := strings.Replace(string(j), kp.Public().Hex(), other, 1)
+
+		if err = json.Unmarshal([]byte(tampered), new(ecc.KeyPair)); err == nil {
+			t.Fatal("expected an error for a mismatching public element")
+		}
+
+		// A zero secret must be rejected.
+		zero, _ := g.NewScalar().GobEncode()
+		if err = new(ecc.KeyPair).UnmarshalBinary(zero); err == nil {
+			t.Fatal("expected an error for a zero secret")
+		}
+	})
+}
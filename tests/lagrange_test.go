@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+// evaluate returns the evaluation at x of the polynomial with the coefficients, of increasing degree.
+func evaluate(coefficients []*ecc.Scalar, x *ecc.Scalar) *ecc.Scalar {
+	y := x.Group().NewScalar()
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y.Multiply(x).Add(coefficients[i])
+	}
+
+	return y
+}
+
+func TestLagrangeCoefficient(t *testing.T) {
+	threshold := 4
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		coefficients := make([]*ecc.Scalar, threshold)
+
+		for i := range coefficients {
+			coefficients[i] = g.NewScalar().Random()
+		}
+
+		// A random signer set, not necessarily of consecutive identifiers.
+		identifiers := []*ecc.Scalar{
+			g.NewScalar().SetUInt64(2), g.NewScalar().Random(), g.NewScalar().SetUInt64(7), g.NewScalar().Random(),
+		}
+
+		all, err := g.LagrangeCoefficients(identifiers)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		secret := g.NewScalar()
+
+		for i, id := range identifiers {
+			lambda, err := g.LagrangeCoefficient(identifiers, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !lambda.Equal(all[i]) {
+				t.Fatalf("expected LagrangeCoefficients to match LagrangeCoefficient for %d", i)
+			}
+
+			secret.Add(lambda.Multiply(evaluate(coefficients, id)))
+		}
+
+		if !secret.Equal(coefficients[0]) {
+			t.Fatal("expected the sum of lambda_i * f(i) to be f(0)")
+		}
+
+		// A single signer's coefficient is 1.
+		lambda, err := g.LagrangeCoefficient(identifiers[:1], identifiers[0])
+		if err != nil || !lambda.Equal(g.NewScalar().One()) {
+			t.Fatal("expected a coefficient of 1 for a single identifier")
+		}
+	})
+}
+
+func TestLagrangeCoefficient_Errors(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		one, two := g.NewScalar().One(), g.NewScalar().SetUInt64(2)
+
+		var wrongGroup ecc.Group = ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		tests := []struct {
+			err         error
+			name        string
+			identifiers []*ecc.Scalar
+		}{
+			{name: "empty", identifiers: nil, err: ecc.ErrNoIdentifiers},
+			{name: "nil", identifiers: []*ecc.Scalar{one, nil}, err: internal.ErrParamNilScalar},
+			{name: "zero", identifiers: []*ecc.Scalar{one, g.NewScalar()}, err: ecc.ErrZeroIdentifier},
+			{name: "duplicate", identifiers: []*ecc.Scalar{one, two, one.Copy()}, err: ecc.ErrDuplicateIdentifier},
+			{name: "group", identifiers: []*ecc.Scalar{one, wrongGroup.NewScalar().One()}, err: ecc.ErrWrongGroup},
+		}
+
+		for _, test := range tests {
+			if _, err := g.LagrangeCoefficient(test.identifiers, one); !errors.Is(err, test.err) {
+				t.Fatalf("%s: expected %q, got %v", test.name, test.err, err)
+			}
+
+			if _, err := g.LagrangeCoefficients(test.identifiers); !errors.Is(err, test.err) {
+				t.Fatalf("%s: expected %q, got %v", test.name, test.err, err)
+			}
+		}
+
+		identifiers := []*ecc.Scalar{one, two}
+
+		_, err := g.LagrangeCoefficient(identifiers, g.NewScalar().SetUInt64(3))
+		if !errors.Is(err, ecc.ErrUnknownIdentifier) {
+			t.Fatalf("expected %q, got %v", ecc.ErrUnknownIdentifier, err)
+		}
+
+		_, err = g.LagrangeCoefficient(identifiers, wrongGroup.NewScalar().One())
+		if !errors.Is(err, ecc.ErrWrongGroup) {
+			t.Fatalf("expected %q, got %v", ecc.ErrWrongGroup, err)
+		}
+
+		if _, err = g.LagrangeCoefficient(identifiers, nil); !errors.Is(err, internal.ErrParamNilScalar) {
+			t.Fatalf("expected %q, got %v", internal.ErrParamNilScalar, err)
+		}
+	})
+}
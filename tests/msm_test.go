@@ -0,0 +1,465 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func randomElement(g ecc.Group) *ecc.Element {
+	return g.RandomElement()
+}
+
+func naiveMultiScalarMult(g ecc.Group, scalars []*ecc.Scalar, elements []*ecc.Element) *ecc.Element {
+	sum := g.NewElement()
+	for i, s := range scalars {
+		sum.Add(elements[i].Copy().Multiply(s))
+	}
+
+	return sum
+}
+
+func TestMultiScalarMult_Empty(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if !group.group.MultiScalarMult(nil, nil).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !group.group.MultiScalarMult([]*ecc.Scalar{}, []*ecc.Element{}).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+	})
+}
+
+func TestMultiScalarMult_LengthMismatch(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		if err := testPanic("length mismatch", internal.ErrParamLengthMismatch, func() {
+			_ = g.MultiScalarMult([]*ecc.Scalar{g.NewScalar().Random()}, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMultiScalarMult_NeutralTerms(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		// Meaningful terms.
+		s1, s2 := g.NewScalar().Random(), g.NewScalar().Random()
+		e1, e2 := randomElement(g), randomElement(g)
+		s1c, e1c := s1.Copy(), e1.Copy()
+		expected := naiveMultiScalarMult(g, []*ecc.Scalar{s1, s2}, []*ecc.Element{e1, e2})
+
+		scalars := []*ecc.Scalar{
+			g.NewScalar().Zero(),
+			s1,
+			g.NewScalar().Random(),
+			nil,
+			g.NewScalar().Random(),
+			s2,
+			g.NewScalar().Zero(),
+		}
+		elements := []*ecc.Element{
+			randomElement(g),
+			e1,
+			g.NewElement().Identity(),
+			randomElement(g),
+			nil,
+			e2,
+			g.NewElement(),
+		}
+
+		if !g.MultiScalarMult(scalars, elements).Equal(expected) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		// Only neutral terms.
+		if !g.MultiScalarMult(scalars[:1], elements[:1]).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !g.MultiScalarMult(
+			[]*ecc.Scalar{g.NewScalar().Zero(), nil, g.NewScalar().Random()},
+			[]*ecc.Element{g.NewElement(), randomElement(g), g.NewElement().Identity()},
+		).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		// The inputs must not be modified.
+		if !scalars[1].Equal(s1c) || !elements[1].Equal(e1c) || !scalars[0].IsZero() || !elements[2].IsIdentity() {
+			t.Fatal("unexpected modification of the input")
+		}
+	})
+}
+
+func TestMultiScalarMult_Random(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		// The sizes cover both Straus' and Pippenger's methods.
+		for _, n := range []int{1, 2, 3, 16, 63, 64, 65, 130} {
+			scalars := make([]*ecc.Scalar, n)
+			elements := make([]*ecc.Element, n)
+
+			for i := range n {
+				scalars[i] = g.NewScalar().Random()
+				elements[i] = randomElement(g)
+			}
+
+			// Add edge-case scalars and repeated elements.
+			scalars[0] = g.NewScalar().MinusOne()
+			if n > 2 {
+				scalars[1] = g.NewScalar().One()
+				elements[2] = elements[0].Copy()
+			}
+
+			if !g.MultiScalarMult(scalars, elements).Equal(naiveMultiScalarMult(g, scalars, elements)) {
+				t.Fatalf("n = %d: %s", n, errExpectedEquality)
+			}
+		}
+
+		// P + (-1) * P is the identity.
+		e := randomElement(g)
+		scalars := []*ecc.Scalar{g.NewScalar().One(), g.NewScalar().MinusOne()}
+		if !g.MultiScalarMult(scalars, []*ecc.Element{e, e}).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+	})
+}
+
+func TestMultiScalarMult_Large(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		p := randomElement(g)
+
+		// The sizes cover several of Pippenger's window widths. The elements are the multiples (i + 1) * P, so that the
+		// expected result is (sum of s_i * (i + 1)) * P.
+		for _, n := range []int{256, 600, 1100} {
+			scalars := make([]*ecc.Scalar, n)
+			elements := make([]*ecc.Element, n)
+			sum := g.NewScalar()
+			e := g.NewElement()
+
+			for i := range n {
+				scalars[i] = g.NewScalar().Random()
+				elements[i] = e.Add(p).Copy()
+				sum.Add(scalars[i].Copy().Multiply(g.NewScalar().SetUInt64(uint64(i) + 1)))
+			}
+
+			if !g.MultiScalarMult(scalars, elements).Equal(p.Copy().Multiply(sum)) {
+				t.Fatalf("n = %d: %s", n, errExpectedEquality)
+			}
+		}
+	})
+}
+
+func TestMultiScalarMultParallel(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		p := randomElement(g)
+
+		for _, n := range []int{0, 1, 100, 300} {
+			scalars := make([]*ecc.Scalar, n)
+			elements := make([]*ecc.Element, n)
+			e := g.NewElement()
+
+			for i := range n {
+				scalars[i] = g.NewScalar().Random()
+				elements[i] = e.Add(p).Copy()
+			}
+
+			// Neutral terms are skipped as in the serial version.
+			if n > 1 {
+				scalars[1] = nil
+			}
+
+			expected := g.MultiScalarMult(scalars, elements)
+
+			for _, workers := range []int{-1, 0, 1, 2, 3, 7} {
+				if !g.MultiScalarMultParallel(scalars, elements, workers).Equal(expected) {
+					t.Fatalf("n = %d, workers = %d: %s", n, workers, errExpectedEquality)
+				}
+			}
+		}
+
+		if err := testPanic("length mismatch", internal.ErrParamLengthMismatch, func() {
+			_ = g.MultiScalarMultParallel([]*ecc.Scalar{g.NewScalar().Random()}, nil, 2)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestMultiScalarMult_WrongGroup(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			_ = g.MultiScalarMult([]*ecc.Scalar{wrongGroup.NewScalar().Random()}, []*ecc.Element{g.Base()})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastElement, func() {
+			_ = g.MultiScalarMult([]*ecc.Scalar{g.NewScalar().Random()}, []*ecc.Element{wrongGroup.Base()})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// wrongGroupPanic returns an error if f doesn't panic with an error matching ecc.ErrWrongGroup.
+func wrongGroupPanic(f func()) (err error) {
+	defer func() {
+		r := recover()
+		if e, ok := r.(error); !ok || !errors.Is(e, ecc.ErrWrongGroup) {
+			err = fmt.Errorf("expected a panic matching ErrWrongGroup, got %v", r)
+		}
+	}()
+
+	f()
+
+	return nil
+}
+
+func TestMultiScalarMult_MixedGroups(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		// The foreign values are placed after valid terms, which must not be computed on beforehand.
+		n := 8
+		scalars := make([]*ecc.Scalar, n)
+		elements := make([]*ecc.Element, n)
+
+		for i := range n {
+			scalars[i], elements[i] = g.NewScalar().Random(), randomElement(g)
+		}
+
+		foreign := wrongGroup.NewScalar().Random()
+		mixedScalars := append(slices.Clone(scalars), foreign)
+		mixedElements := append(slices.Clone(elements), wrongGroup.Base())
+		validScalars := append(slices.Clone(scalars), scalars[0])
+		validElements := append(slices.Clone(elements), g.Base())
+
+		for name, f := range map[string]func(){
+			"MultiScalarMult scalar":  func() { g.MultiScalarMult(mixedScalars, validElements) },
+			"MultiScalarMult element": func() { g.MultiScalarMult(validScalars, mixedElements) },
+			"MultiScalarMultParallel": func() { g.MultiScalarMultParallel(mixedScalars, mixedElements, 2) },
+			"DoubleScalarBaseMult":    func() { g.DoubleScalarBaseMult(scalars[0], elements[0], foreign, g.Base()) },
+			"BaseMultAll":             func() { g.BaseMultAll(mixedScalars) },
+			"ScalarBaseMult":          func() { g.ScalarBaseMult(foreign) },
+			"Sum":                     func() { g.Sum(mixedElements...) },
+			"SumElements":             func() { ecc.SumElements(mixedElements) },
+		} {
+			if err := wrongGroupPanic(f); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+		}
+	})
+}
+
+func TestBaseMultAll(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if len(g.BaseMultAll(nil)) != 0 {
+			t.Fatal("expected empty output")
+		}
+
+		scalars := []*ecc.Scalar{
+			g.NewScalar().Random(),
+			nil,
+			g.NewScalar().One(),
+			g.NewScalar().Zero(),
+			g.NewScalar().Random(),
+			g.NewScalar().MinusOne(),
+		}
+
+		elements := g.BaseMultAll(scalars)
+		if len(elements) != len(scalars) {
+			t.Fatalf("expected %d elements, got %d", len(scalars), len(elements))
+		}
+
+		for i, s := range scalars {
+			if s == nil || s.IsZero() {
+				if !elements[i].IsIdentity() {
+					t.Fatalf("%d: %s", i, errExpectedIdentity)
+				}
+
+				continue
+			}
+
+			if !elements[i].Equal(g.Base().Multiply(s)) {
+				t.Fatalf("%d: %s", i, errExpectedEquality)
+			}
+		}
+
+		if !elements[2].Equal(g.Base()) {
+			t.Fatal("expected 1 * G = G")
+		}
+
+		// The products, which some backends normalize, encode and compute as the single products.
+		if !bytes.Equal(elements[0].Encode(), g.ScalarBaseMult(scalars[0]).Encode()) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		sum := elements[0].Copy().Add(elements[4]).Add(elements[5])
+		if !sum.Equal(g.ScalarBaseMult(scalars[0].Copy().Add(scalars[4]).Add(scalars[5]))) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestSum(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if !g.Sum().IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if ecc.SumElements(nil) != nil || ecc.SumElements([]*ecc.Element{nil}) != nil {
+			t.Fatal("expected nil sum without elements")
+		}
+
+		elements := []*ecc.Element{randomElement(g), nil, randomElement(g), g.NewElement(), randomElement(g)}
+		copies := make([]*ecc.Element, len(elements))
+		expected := g.NewElement()
+
+		for i, e := range elements {
+			if e != nil {
+				copies[i] = e.Copy()
+				expected.Add(e)
+			}
+		}
+
+		if !g.Sum(elements...).Equal(expected) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !ecc.SumElements(elements).Equal(expected) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for i, e := range elements {
+			if e != nil && !e.Equal(copies[i]) {
+				t.Fatal("unexpected modification of the input")
+			}
+		}
+
+		// P + (-P) = 0
+		e := randomElement(g)
+		if !g.Sum(e, e.Copy().Negate()).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastElement, func() {
+			_ = g.Sum(g.Base(), wrongGroup.Base())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastElement, func() {
+			_ = ecc.SumElements([]*ecc.Element{nil, g.Base(), wrongGroup.Base()})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestDoubleScalarBaseMult(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for range 5 {
+			s1, s2 := g.NewScalar().Random(), g.NewScalar().Random()
+			e1, e2 := randomElement(g), randomElement(g)
+			expected := e1.Copy().Multiply(s1).Add(e2.Copy().Multiply(s2))
+
+			if !g.DoubleScalarBaseMult(s1, e1, s2, e2).Equal(expected) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			expected = g.Base().Multiply(s1).Add(e2.Copy().Multiply(s2))
+			if !g.DoubleScalarBaseMultBase(s1, s2, e2).Equal(expected) {
+				t.Fatal(errExpectedEquality)
+			}
+
+			// Schnorr-like verification: s*G - c*A = R.
+			sk, k, c := g.NewScalar().Random(), g.NewScalar().Random(), g.NewScalar().Random()
+			pk, r := g.Base().Multiply(sk), g.Base().Multiply(k)
+			s := k.Copy().Add(c.Copy().Multiply(sk))
+
+			if !g.DoubleScalarBaseMultBase(s, g.NewScalar().Subtract(c), pk).Equal(r) {
+				t.Fatal(errExpectedEquality)
+			}
+		}
+
+		// Neutral inputs.
+		e := randomElement(g)
+		s := g.NewScalar().Random()
+
+		if !g.DoubleScalarBaseMult(s, e, nil, nil).Equal(e.Copy().Multiply(s)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.DoubleScalarBaseMult(g.NewScalar(), e, s, g.NewElement()).IsIdentity() {
+			t.Fatal(errExpectedIdentity)
+		}
+
+		if !g.DoubleScalarBaseMultBase(nil, s, e).Equal(e.Copy().Multiply(s)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.DoubleScalarBaseMultBase(s, nil, e).Equal(g.Base().Multiply(s)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastElement, func() {
+			_ = g.DoubleScalarBaseMult(s, e, s, wrongGroup.Base())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			_ = g.DoubleScalarBaseMultBase(wrongGroup.NewScalar().Random(), s, e)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -14,6 +14,9 @@ import (
 	"errors"
 	"math/big"
 	"testing"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/internal/nist"
 )
 
 var errParamNotOnCurve = errors.New("point is not on curve")
@@ -82,6 +85,33 @@ func solveNist(x, b, order *big.Int) *big.Int {
 
 type solver func(x *big.Int) *big.Int
 
+type nistMapper interface {
+	MapToCurve(u *big.Int) internal.Element
+}
+
+// TestNistMapToCurveZero tests the exceptional case of the SSWU map, u = 0, for which x1 = B / (Z * A) must be on the
+// curve as required by the choice of Z.
+func TestNistMapToCurveZero(t *testing.T) {
+	for _, g := range []internal.Group{nist.P224(), nist.P256(), nist.P384(), nist.P521()} {
+		e := g.(nistMapper).MapToCurve(new(big.Int))
+		if e.IsIdentity() {
+			t.Fatalf("%s: unexpected identity", g.Ciphersuite())
+		}
+	}
+
+	// For P-224, whose suite isn't defined by RFC9380, Z = 31.
+	p := new(big.Int).SetBytes(nist.P224().FieldPrime())
+	b, _ := new(big.Int).SetString("b4050a850c04b3abf54132565044b0b7d7bfd8ba270b39432355ffb4", 16)
+	x := new(big.Int).Mul(big.NewInt(31), big.NewInt(-3))
+	x.ModInverse(x.Mod(x, p), p)
+	x.Mul(x, b).Mod(x, p)
+
+	e := nist.P224().(nistMapper).MapToCurve(new(big.Int))
+	if new(big.Int).SetBytes(e.XCoordinate()).Cmp(x) != 0 {
+		t.Fatal("unexpected x coordinate for u = 0")
+	}
+}
+
 func isOnCurve(x, y, order *big.Int, solve solver) error {
 	// Reject integers below 0 or higher than the field order.
 	if x.Sign() < 0 || x.Cmp(order) >= 0 ||
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/nizk"
+)
+
+func TestNIZK_DLog(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+		base := g.HashToGroup([]byte("base"), []byte("nizk test base"))
+		point := base.Copy().Multiply(sk)
+
+		proof := nizk.ProveDLog(sk, base, point)
+		if !nizk.VerifyDLog(proof, base, point) {
+			t.Fatal("valid proof failed verification")
+		}
+
+		var decoded nizk.Proof
+		if err := decoded.Decode(g, proof.Encode()); err != nil {
+			t.Fatal(err)
+		}
+
+		if !nizk.VerifyDLog(decoded, base, point) {
+			t.Fatal("decoded proof failed verification")
+		}
+
+		// Soundness: a proof with a wrong witness, or for another statement, must fail.
+		if nizk.VerifyDLog(nizk.ProveDLog(g.NewScalar().Random(), base, point), base, point) {
+			t.Fatal("expected failure with a wrong witness")
+		}
+
+		if nizk.VerifyDLog(proof, g.Base(), point) || nizk.VerifyDLog(proof, base, point.Copy().Double()) {
+			t.Fatal("expected failure on another statement")
+		}
+
+		tampered := nizk.Proof{Challenge: proof.Challenge, Response: proof.Response.Copy().Add(g.NewScalar().One())}
+		if nizk.VerifyDLog(tampered, base, point) {
+			t.Fatal("expected failure on tampered proof")
+		}
+
+		if nizk.VerifyDLog(nizk.Proof{}, base, point) || nizk.VerifyDLog(proof, nil, point) ||
+			nizk.VerifyDLog(proof, base, g.NewElement()) {
+			t.Fatal("expected failure on invalid input")
+		}
+
+		// A DLog proof is not a DLEQ proof.
+		if nizk.VerifyDLEQ(proof, base, point, base, point) {
+			t.Fatal("expected failure across proof types")
+		}
+	})
+}
+
+func TestNIZK_DLEQ(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+		h := g.HashToGroup([]byte("h"), []byte("nizk test base"))
+		a := g.ScalarBaseMult(sk)
+		b := h.Copy().Multiply(sk)
+
+		proof := nizk.ProveDLEQ(sk, g.Base(), a, h, b)
+		if !nizk.VerifyDLEQ(proof, g.Base(), a, h, b) {
+			t.Fatal("valid proof failed verification")
+		}
+
+		// Soundness: different discrete logarithms, or a wrong witness, must fail.
+		b2 := h.Copy().Multiply(g.NewScalar().Random())
+		if nizk.VerifyDLEQ(nizk.ProveDLEQ(sk, g.Base(), a, h, b2), g.Base(), a, h, b2) {
+			t.Fatal("expected failure on unequal discrete logarithms")
+		}
+
+		if nizk.VerifyDLEQ(nizk.ProveDLEQ(g.NewScalar().Random(), g.Base(), a, h, b), g.Base(), a, h, b) {
+			t.Fatal("expected failure with a wrong witness")
+		}
+
+		if nizk.VerifyDLEQ(proof, g.Base(), a, h, b2) || nizk.VerifyDLEQ(proof, h, b, g.Base(), a) {
+			t.Fatal("expected failure on another statement")
+		}
+	})
+}
+
+func TestNIZK_Fails(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+
+		if err := testPanic("nil scalar", internal.ErrParamNilScalar, func() {
+			_ = nizk.ProveDLog(nil, g.Base(), g.Base())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil element", internal.ErrParamNilPoint, func() {
+			_ = nizk.ProveDLog(sk, g.Base(), nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		var proof nizk.Proof
+		if err := proof.Decode(g, make([]byte, g.ScalarLength())); !errors.Is(err, internal.ErrDecodingInvalidLength) {
+			t.Fatalf("expected invalid length error, got %v", err)
+		}
+	})
+}
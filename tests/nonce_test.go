@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestDeterministicNonce_RFC6979(t *testing.T) {
+	// RFC 6979 appendix A.2.5, P-256 with SHA-256.
+	g := ecc.P256Sha256
+	sk := decodeScalar(t, g, "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+
+	for msg, k := range map[string]string{
+		"sample": "a6e3c57dd01abe90086538398355dd4c3b17aa873382b0f24d6129493d8aad60",
+		"test":   "d16b6ae827f17175e040871a1c7ec3500192c4c92677336ec2537acaee0008e0",
+	} {
+		if nonce := g.DeterministicNonce(sk, []byte(msg), nil); nonce.Hex() != k {
+			t.Fatalf("%s: unexpected nonce %s", msg, nonce.Hex())
+		}
+	}
+}
+
+func TestDeterministicNonce(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.RandomScalar()
+		msg := []byte("message")
+
+		k := g.DeterministicNonce(sk, msg, nil)
+		if k.IsZero() || k.Group() != g {
+			t.Fatal("expected a non-zero nonce of the group")
+		}
+
+		if !k.Equal(g.DeterministicNonce(sk, msg, nil)) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		for _, other := range []*ecc.Scalar{
+			g.DeterministicNonce(sk, []byte("other message"), nil),
+			g.DeterministicNonce(g.RandomScalar(), msg, nil),
+			g.DeterministicNonce(sk, msg, []byte("extra")),
+		} {
+			if k.Equal(other) {
+				t.Fatal("expected different nonces")
+			}
+		}
+
+		if err := testPanic("nil key", internal.ErrParamNilScalar, func() {
+			_ = g.DeterministicNonce(nil, msg, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("zero key", internal.ErrParamNilScalar, func() {
+			_ = g.DeterministicNonce(g.NewScalar(), msg, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		other := ecc.Ristretto255Sha512
+		if g == other {
+			other = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			_ = g.DeterministicNonce(other.RandomScalar(), msg, nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
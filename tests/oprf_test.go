@@ -0,0 +1,303 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/oprf"
+)
+
+var (
+	oprfGroups = []ecc.Group{ecc.Ristretto255Sha512, ecc.P256Sha256}
+	oprfModes  = []oprf.Mode{oprf.ModeOPRF, oprf.ModeVOPRF}
+)
+
+type oprfVector struct {
+	input     string
+	blind     string
+	blinded   string
+	evaluated string
+	output    string
+}
+
+type oprfVectors struct {
+	group     ecc.Group
+	mode      oprf.Mode
+	secretKey string
+	publicKey string
+	vectors   []oprfVector
+}
+
+// RFC 9497 appendix A, with Seed = a3...a3 and KeyInfo = "test key". Blinded and evaluated elements are only checked
+// when the blind is given.
+var oprfTestVectors = []oprfVectors{
+	{
+		group:     ecc.Ristretto255Sha512,
+		mode:      oprf.ModeOPRF,
+		secretKey: "5ebcea5ee37023ccb9fc2d2019f9d7737be85591ae8652ffa9ef0f4d37063b0e",
+		vectors: []oprfVector{
+			{
+				input: "00",
+				output: "527759c3d9366f277d8c6020418d96bb393ba2afb20ff90df23fb7708264e2f3ab9135e3bd69955851de4b1f9fe8a" +
+					"0973396719b7912ba9ee8aa7d0b5e24bcf6",
+			},
+			{
+				input: "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+				output: "f4a74c9c592497375e796aa837e907b1a045d34306a749db9f34221f7e750cb4f2a6413a6bf6fa5e19ba6348eb6739" +
+					"34a722a7ede2e7621306d18951e7cf2c73",
+			},
+		},
+	},
+	{
+		group:     ecc.Ristretto255Sha512,
+		mode:      oprf.ModeVOPRF,
+		secretKey: "e6f73f344b79b379f1a0dd37e07ff62e38d9f71345ce62ae3a9bc60b04ccd909",
+		publicKey: "c803e2cc6b05fc15064549b5920659ca4a77b2cca6f04f6b357009335476ad4e",
+	},
+	{
+		group:     ecc.P256Sha256,
+		mode:      oprf.ModeOPRF,
+		secretKey: "159749d750713afe245d2d39ccfaae8381c53ce92d098a9375ee70739c7ac0bf",
+		vectors: []oprfVector{
+			{
+				input:     "00",
+				blind:     "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blinded:   "03723a1e5c09b8b9c18d1dcbca29e8007e95f14f4732d9346d490ffc195110368d",
+				evaluated: "030de02ffec47a1fd53efcdd1c6faf5bdc270912b8749e783c7ca75bb412958832",
+				output:    "a0b34de5fa4c5b6da07e72af73cc507cceeb48981b97b7285fc375345fe495dd",
+			},
+			{
+				input:     "5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a5a",
+				blind:     "3338fa65ec36e0290022b48eb562889d89dbfa691d1cde91517fa222ed7ad364",
+				blinded:   "03cc1df781f1c2240a64d1c297b3f3d16262ef5d4cf102734882675c26231b0838",
+				evaluated: "03a0395fe3828f2476ffcd1f4fe540e5a8489322d398be3c4e5a869db7fcb7c52c",
+				output:    "c748ca6dd327f0ce85f4ae3a8cd6d4d5390bbb804c9e12dcf94f853fece3dcce",
+			},
+		},
+	},
+}
+
+func TestOPRF_Vectors(t *testing.T) {
+	seed := bytes.Repeat([]byte{0xa3}, 32)
+
+	for _, tv := range oprfTestVectors {
+		t.Run(tv.group.String(), func(t *testing.T) {
+			sk, pk, err := oprf.DeriveKeyPair(tv.group, tv.mode, seed, []byte("test key"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if sk.Hex() != tv.secretKey {
+				t.Fatalf("unexpected secret key %s", sk.Hex())
+			}
+
+			if tv.publicKey != "" && pk.Hex() != tv.publicKey {
+				t.Fatalf("unexpected public key %s", pk.Hex())
+			}
+
+			server, err := oprf.NewServer(tv.group, tv.mode, sk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client, err := oprf.NewClient(tv.group, tv.mode, pk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, v := range tv.vectors {
+				testOPRFVector(t, client, server, v)
+			}
+		})
+	}
+}
+
+func testOPRFVector(t *testing.T, client *oprf.Client, server *oprf.Server, v oprfVector) {
+	g := server.PublicKey().Group()
+
+	input, err := hex.DecodeString(v.input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var blind *ecc.Scalar
+
+	var blinded *ecc.Element
+
+	if v.blind != "" {
+		blind = decodeScalar(t, g, v.blind)
+
+		blinded, err = client.DeterministicBlind(input, blind)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if blinded.Hex() != v.blinded {
+			t.Fatalf("unexpected blinded element %s", blinded.Hex())
+		}
+	} else if blind, blinded, err = client.Blind(input); err != nil {
+		t.Fatal(err)
+	}
+
+	evaluated, proof, err := server.BlindEvaluate(blinded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v.evaluated != "" && evaluated.Hex() != v.evaluated {
+		t.Fatalf("unexpected evaluated element %s", evaluated.Hex())
+	}
+
+	output, err := client.Finalize(input, blind, evaluated, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(output) != v.output {
+		t.Fatalf("unexpected output %x", output)
+	}
+
+	output, err = server.Evaluate(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(output) != v.output {
+		t.Fatalf("unexpected server output %x", output)
+	}
+}
+
+func TestOPRF(t *testing.T) {
+	for _, g := range oprfGroups {
+		for _, mode := range oprfModes {
+			sk := g.NewScalar().Random()
+
+			server, err := oprf.NewServer(g, mode, sk)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			client, err := oprf.NewClient(g, mode, server.PublicKey())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			input := []byte("input")
+
+			blind, blinded, err := client.Blind(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			evaluated, proof, err := server.BlindEvaluate(blinded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if (proof == nil) != (mode == oprf.ModeOPRF) {
+				t.Fatalf("%s: unexpected proof %x", g, proof)
+			}
+
+			output, err := client.Finalize(input, blind, evaluated, proof)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, err := server.Evaluate(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(output, expected) {
+				t.Fatalf("%s: unexpected output", g)
+			}
+
+			if mode == oprf.ModeOPRF {
+				continue
+			}
+
+			// An evaluation with another key or a tampered proof must be rejected.
+			other, err := oprf.NewServer(g, mode, g.NewScalar().Random())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			evaluated2, proof2, err := other.BlindEvaluate(blinded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err = client.Finalize(input, blind, evaluated2, proof2); !errors.Is(err, oprf.ErrVerify) {
+				t.Fatalf("%s: expected verification error, got %v", g, err)
+			}
+
+			proof[0] ^= 1
+			if _, err = client.Finalize(input, blind, evaluated, proof); !errors.Is(err, oprf.ErrVerify) {
+				t.Fatalf("%s: expected verification error, got %v", g, err)
+			}
+
+			if _, err = client.Finalize(input, blind, evaluated, nil); !errors.Is(err, oprf.ErrVerify) {
+				t.Fatalf("%s: expected verification error, got %v", g, err)
+			}
+		}
+	}
+}
+
+func TestOPRF_Fails(t *testing.T) {
+	if _, err := oprf.NewClient(ecc.Secp256k1Sha256, oprf.ModeOPRF, nil); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("expected unsupported group error, got %v", err)
+	}
+
+	if _, err := oprf.NewServer(ecc.P256Sha256, 0x02, ecc.P256Sha256.NewScalar().Random()); err == nil {
+		t.Fatal("expected error on unsupported mode")
+	}
+
+	if _, _, err := oprf.DeriveKeyPair(ecc.P384Sha384, oprf.ModeOPRF, nil, nil); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("expected unsupported group error, got %v", err)
+	}
+
+	for _, g := range oprfGroups {
+		if _, err := oprf.NewServer(g, oprf.ModeOPRF, g.NewScalar()); !errors.Is(err, internal.ErrParamNilScalar) {
+			t.Fatalf("expected nil scalar error, got %v", err)
+		}
+
+		if _, err := oprf.NewClient(g, oprf.ModeVOPRF, nil); !errors.Is(err, internal.ErrParamNilPoint) {
+			t.Fatalf("expected nil point error, got %v", err)
+		}
+
+		server, err := oprf.NewServer(g, oprf.ModeOPRF, g.NewScalar().Random())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, _, err = server.BlindEvaluate(g.NewElement()); !errors.Is(err, internal.ErrIdentity) {
+			t.Fatalf("expected identity error, got %v", err)
+		}
+
+		client, err := oprf.NewClient(g, oprf.ModeOPRF, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		long := make([]byte, 1<<16)
+		if _, _, err = client.Blind(long); !errors.Is(err, oprf.ErrInvalidInput) {
+			t.Fatalf("expected invalid input error, got %v", err)
+		}
+
+		if _, err = server.Evaluate(long); !errors.Is(err, oprf.ErrInvalidInput) {
+			t.Fatalf("expected invalid input error, got %v", err)
+		}
+	}
+}
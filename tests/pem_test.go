@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+)
+
+func TestElement_PEM(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		if !hasDER(group.group) {
+			return
+		}
+
+		e := group.group.Base().Multiply(group.group.NewScalar().Random())
+
+		encoded, err := e.MarshalPEM()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := ecc.DecodeElementPEM(group.group, encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(e) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		block, _ := pem.Decode(encoded)
+		block.Type = "PRIVATE KEY"
+
+		if _, err = ecc.DecodeElementPEM(group.group, pem.EncodeToMemory(block)); !errors.Is(err, ecc.ErrPEMBlockType) {
+			t.Fatalf("expected block type error, got %v", err)
+		}
+
+		if _, err = ecc.DecodeElementPEM(group.group, block.Bytes); err == nil {
+			t.Fatal("expected error on missing PEM block")
+		}
+	})
+}
+
+func TestScalar_PEM(t *testing.T) {
+	for _, g := range []ecc.Group{ecc.P256Sha256, ecc.P384Sha384, ecc.Secp256k1Sha256} {
+		s := g.NewScalar().Random()
+
+		encoded, err := s.MarshalPEM()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded, err := ecc.DecodeScalarPEM(g, encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(s) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		public, err := g.Base().Multiply(s).MarshalPEM()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = ecc.DecodeScalarPEM(g, public); !errors.Is(err, ecc.ErrPEMBlockType) {
+			t.Fatalf("expected block type error, got %v", err)
+		}
+
+		if _, err = ecc.DecodeElementPEM(g, encoded); !errors.Is(err, ecc.ErrPEMBlockType) {
+			t.Fatalf("expected block type error, got %v", err)
+		}
+	}
+
+	if _, err := ecc.Ristretto255Sha512.NewScalar().Random().MarshalPEM(); !errors.Is(err, errors.ErrUnsupported) {
+		t.Fatalf("expected unsupported error, got %v", err)
+	}
+}
+
+func TestDecodeScalarPEM_ECPrivateKey(t *testing.T) {
+	s, key := ecdsaKey(t, ecc.P256Sha256, elliptic.P256())
+
+	encoded, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encoded})
+
+	decoded, err := ecc.DecodeScalarPEM(ecc.P256Sha256, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !decoded.Equal(s) {
+		t.Fatal(errExpectedEquality)
+	}
+
+	// The curve named in the key must match the group.
+	if _, err = ecc.DecodeScalarPEM(ecc.P384Sha384, block); !errors.Is(err, ecc.ErrDERAlgorithm) {
+		t.Fatalf("expected algorithm error, got %v", err)
+	}
+}
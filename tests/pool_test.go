@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestGroup_Pool(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		// Values returned to the pool are reset, whether or not they are reused.
+		for range 10 {
+			s := g.GetScalar()
+			if !s.IsZero() {
+				t.Fatal("expected zero scalar")
+			}
+
+			e := g.GetElement()
+			if !e.IsIdentity() {
+				t.Fatal(errExpectedIdentity)
+			}
+
+			g.PutScalar(s.Random())
+			g.PutElement(e.Base())
+		}
+
+		g.PutScalar(nil)
+		g.PutElement(nil)
+
+		wrongGroup := ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group scalar", internal.ErrCastScalar, func() {
+			g.PutScalar(wrongGroup.NewScalar())
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("wrong group element", internal.ErrCastElement, func() {
+			g.PutElement(wrongGroup.NewElement())
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestGroup_Pool_InvalidGroup(t *testing.T) {
+	if err := testPanic("invalid group", internal.ErrInvalidGroup, func() {
+		_ = ecc.Group(0).GetScalar()
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testPanic("invalid group", internal.ErrInvalidGroup, func() {
+		_ = ecc.Group(0).GetElement()
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -10,12 +10,15 @@ package ecc_test
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"io"
 	"math"
 	"math/big"
 	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/0xBridge/ecc"
@@ -55,9 +58,11 @@ func TestScalar_WrongInput(t *testing.T) {
 
 		switch group.group {
 		// The following is arbitrary, and simply aims at confusing identifiers
-		case ecc.Ristretto255Sha512, ecc.Edwards25519Sha512, ecc.Secp256k1Sha256:
+		case ecc.Ristretto255Sha512, ecc.Decaf448Shake256, ecc.Edwards25519Sha512, ecc.Secp256k1Sha256,
+			ecc.Edwards448Shake256, ecc.BLS12381G1Sha256, ecc.PallasSha256,
+			ecc.VestaSha256:
 			wrongGroup = ecc.P256Sha256
-		case ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512:
+		case ecc.P256Sha256, ecc.P384Sha384, ecc.P521Sha512, ecc.P224Sha256:
 			wrongGroup = ecc.Ristretto255Sha512
 
 			// Add a special test for nist groups, using a different field
@@ -195,7 +200,8 @@ func TestScalar_SetUInt64(t *testing.T) {
 		ref := make([]byte, group.group.ScalarLength())
 
 		switch group.group {
-		case ecc.Ristretto255Sha512, ecc.Edwards25519Sha512:
+		case ecc.Ristretto255Sha512, ecc.Decaf448Shake256, ecc.Edwards25519Sha512, ecc.Edwards448Shake256,
+			ecc.PallasSha256, ecc.VestaSha256:
 			binary.LittleEndian.PutUint64(ref, math.MaxUint64)
 		default:
 			binary.BigEndian.PutUint64(ref[group.group.ScalarLength()-8:], math.MaxUint64)
@@ -207,6 +213,55 @@ func TestScalar_SetUInt64(t *testing.T) {
 	})
 }
 
+func TestScalar_TextSetString(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, v := range []uint64{0, 1, 255, 256, 1 << 32, math.MaxUint64} {
+			decimal := strconv.FormatUint(v, 10)
+
+			if text := g.NewScalarFromUint64(v).Text(10); text != decimal {
+				t.Fatalf("expected %s, got %s", decimal, text)
+			}
+
+			s := g.NewScalar()
+			if err := s.SetString(decimal, 10); err != nil || !s.Equal(g.NewScalarFromUint64(v)) {
+				t.Fatalf("unexpected SetString for %s: %v", decimal, err)
+			}
+		}
+
+		minusOne := new(big.Int).Sub(g.OrderBigInt(), big.NewInt(1))
+		if text := g.NewScalar().MinusOne().Text(16); text != minusOne.Text(16) {
+			t.Fatalf("expected %s, got %s", minusOne.Text(16), text)
+		}
+
+		// SetString(Text(s)) must round trip in any base, and Text must match the integer value of the encoding.
+		for _, r := range []*ecc.Scalar{g.NewScalar().MinusOne(), g.RandomScalar(), g.RandomScalar()} {
+			if r.Text(10) != scalarToBigInt(g, r).Text(10) {
+				t.Fatalf("unexpected Text %s", r.Text(10))
+			}
+
+			for _, base := range []int{2, 10, 16, 36, 62} {
+				s := g.NewScalar()
+				if err := s.SetString(r.Text(base), base); err != nil || !s.Equal(r) {
+					t.Fatalf("unexpected SetString round trip in base %d: %v", base, err)
+				}
+			}
+		}
+
+		for _, bad := range []string{"", "12a", "-1", g.OrderBigInt().String()} {
+			s := g.NewScalar().One()
+			if err := s.SetString(bad, 10); !errors.Is(err, ecc.ErrInvalidScalarEncoding) {
+				t.Fatalf("expected ErrInvalidScalarEncoding for %q, got %v", bad, err)
+			}
+
+			if !s.Equal(g.NewScalar().One()) {
+				t.Fatal("expected the receiver to be untouched")
+			}
+		}
+	})
+}
+
 func TestScalar_EncodedLength(t *testing.T) {
 	testAllGroups(t, func(group *testGroup) {
 		encodedScalar := group.group.NewScalar().Random().Encode()
@@ -262,12 +317,27 @@ func TestScalar_Arithmetic(t *testing.T) {
 		scalarTestMinusOne(t, group.group)
 		scalarTestEqual(t, group.group)
 		scalarTestLessOrEqual(t, group.group)
+		scalarTestCmp(t, group.group)
+		scalarTestBit(t, group.group)
+		scalarTestSetBytesReduced(t, group.group)
 		scalarTestRandom(t, group.group)
+		scalarTestRandomFromReader(t, group.group)
 		scalarTestAdd(t, group.group)
 		scalarTestSubtract(t, group.group)
 		scalarTestMultiply(t, group.group)
+		scalarTestMultiplyAdd(t, group.group)
+		scalarTestUint64Arithmetic(t, group.group)
 		scalarTestPow(t, group.group)
+		scalarTestPowUint64(t, group.group)
+		scalarTestPowZeroBase(t, group.group)
 		scalarTestInvert(t, group.group)
+		scalarTestHalve(t, group.group)
+		scalarTestCondNegate(t, group.group)
+		scalarTestActOnBase(t, group.group)
+		scalarTestCSwap(t, group.group)
+		scalarTestPowBig(t, group.group)
+		scalarTestSetBigInt(t, group.group)
+		scalarTestInto(t, group.group)
 	})
 }
 
@@ -316,6 +386,39 @@ func scalarTestRandom(t *testing.T, g ecc.Group) {
 	}
 }
 
+func scalarTestRandomFromReader(t *testing.T, g ecc.Group) {
+	length := g.ScalarLength() + 16
+	seed := bytes.Repeat([]byte{0xa5}, length)
+
+	s1, err := g.NewScalar().RandomFromReader(bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s2, err := g.NewScalar().RandomFromReader(bytes.NewReader(seed))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !s1.Equal(s2) || !s1.Equal(g.NewScalar().SetBigInt(new(big.Int).SetBytes(seed))) {
+		t.Fatal("expected the scalar to be the reduction of the bytes read")
+	}
+
+	// Zero scalars are rejected, and more bytes read.
+	s3, err := g.NewScalar().RandomFromReader(bytes.NewReader(append(make([]byte, length), seed...)))
+	if err != nil || !s3.Equal(s1) {
+		t.Fatal("expected a zero scalar to be skipped")
+	}
+
+	if _, err = g.NewScalar().RandomFromReader(bytes.NewReader(make([]byte, 100*length))); err == nil {
+		t.Fatal("expected an error for a reader only yielding zero scalars")
+	}
+
+	if _, err = g.NewScalar().RandomFromReader(bytes.NewReader(seed[1:])); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected %q, got %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
 func scalarTestEqual(t *testing.T, g ecc.Group) {
 	zero := g.NewScalar().Zero()
 	zero2 := g.NewScalar().Zero()
@@ -383,6 +486,153 @@ func scalarTestLessOrEqual(t *testing.T, g ecc.Group) {
 	}
 }
 
+func scalarToBigInt(g ecc.Group, s *ecc.Scalar) *big.Int {
+	e := s.Encode()
+	if g == ecc.Ristretto255Sha512 || g == ecc.Decaf448Shake256 || g == ecc.Edwards25519Sha512 ||
+		g == ecc.Edwards448Shake256 || g == ecc.PallasSha256 || g == ecc.VestaSha256 {
+		slices.Reverse(e)
+	}
+
+	return new(big.Int).SetBytes(e)
+}
+
+func scalarTestCmp(t *testing.T, g ecc.Group) {
+	zero := g.NewScalar().Zero()
+	one := g.NewScalar().One()
+	s255 := g.NewScalar().SetUInt64(255)
+	s256 := g.NewScalar().SetUInt64(256)
+	minusOne := g.NewScalar().MinusOne()
+
+	tests := []struct {
+		a, b     *ecc.Scalar
+		expected int
+	}{
+		{zero, zero, 0},
+		{zero, one, -1},
+		{one, zero, 1},
+		{s255, s256, -1}, // would fail a naive byte comparison of little-endian encodings
+		{s256, s255, 1},
+		{s256, s256.Copy(), 0},
+		{minusOne, one, 1},
+		{zero, minusOne, -1},
+		{minusOne, minusOne.Copy(), 0},
+	}
+
+	for i, test := range tests {
+		if res := test.a.Cmp(test.b); res != test.expected {
+			t.Fatalf("test %d: expected %d, got %d", i, test.expected, res)
+		}
+	}
+
+	// Sorting with Cmp must give the same order as with the integer values.
+	scalars := make([]*ecc.Scalar, 32)
+	for i := range scalars {
+		scalars[i] = g.NewScalar().Random()
+	}
+
+	slices.SortFunc(scalars, func(a, b *ecc.Scalar) int {
+		return a.Cmp(b)
+	})
+
+	for i := 1; i < len(scalars); i++ {
+		if scalarToBigInt(g, scalars[i-1]).Cmp(scalarToBigInt(g, scalars[i])) > 0 {
+			t.Fatal("scalars are not correctly ordered")
+		}
+	}
+
+	// A nil scalar compares lower than any scalar, as LessOrEqual reports.
+	if zero.Cmp(nil) != 1 || minusOne.Cmp(nil) != 1 {
+		t.Fatal("expected nil to compare lower")
+	}
+
+	// Scalars of another group panic in Cmp as they do in Equal and LessOrEqual.
+	wrongGroup := ecc.Ristretto255Sha512
+	if g == ecc.Ristretto255Sha512 {
+		wrongGroup = ecc.P256Sha256
+	}
+
+	other := wrongGroup.NewScalar().One()
+
+	for _, f := range []func(){
+		func() { _ = one.Equal(other) },
+		func() { _ = one.LessOrEqual(other) },
+		func() { _ = one.Cmp(other) },
+	} {
+		if err := testPanic("wrong group", internal.ErrCastScalar, f); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func scalarTestBit(t *testing.T, g ecc.Group) {
+	// 5 = 0b101
+	five := g.NewScalar().SetUInt64(5)
+	for i, expected := range []int{1, 0, 1, 0, 0, 0, 0, 0, 0} {
+		if b := five.Bit(i); b != expected {
+			t.Fatalf("expected bit %d of 5 to be %d, got %d", i, expected, b)
+		}
+	}
+
+	// 256 = 1 << 8
+	s := g.NewScalar().SetUInt64(256)
+	if s.Bit(8) != 1 || s.Bit(0) != 0 || s.Bit(7) != 0 || s.Bit(9) != 0 {
+		t.Fatal("unexpected bits for 256")
+	}
+
+	// Out of range indices.
+	bitLen := 8 * g.ScalarLength()
+	minusOne := g.NewScalar().MinusOne()
+	if minusOne.Bit(-1) != 0 || minusOne.Bit(bitLen) != 0 || minusOne.Bit(bitLen+100) != 0 {
+		t.Fatal("expected out of range bits to be 0")
+	}
+
+	// Random scalars must match their integer value.
+	s = g.NewScalar().Random()
+	i := scalarToBigInt(g, s)
+
+	for j := 0; j < bitLen; j++ {
+		if s.Bit(j) != int(i.Bit(j)) {
+			t.Fatalf("unexpected bit %d", j)
+		}
+	}
+}
+
+func scalarTestSetBytesReduced(t *testing.T, g ecc.Group) {
+	order := g.OrderBigInt()
+
+	for _, length := range []int{0, 1, 8, 20, g.ScalarLength(), 48, 64, 100} {
+		in := internal.RandomBytes(length)
+		expected := new(big.Int).Mod(new(big.Int).SetBytes(in), order)
+
+		s := g.NewScalar().SetBytesReduced(in)
+		if scalarToBigInt(g, s).Cmp(expected) != 0 {
+			t.Fatalf("unexpected reduction for input length %d", length)
+		}
+	}
+
+	// Small values are left untouched.
+	if !g.NewScalar().SetBytesReduced([]byte{1, 0}).Equal(g.NewScalar().SetUInt64(256)) {
+		t.Fatal(errExpectedEquality)
+	}
+
+	// The order and its multiples reduce to 0.
+	orderBytes := order.Bytes()
+	if !g.NewScalar().SetBytesReduced(orderBytes).IsZero() {
+		t.Fatal(errExpectedIdentity)
+	}
+
+	twice := new(big.Int).Lsh(order, 1).Bytes()
+	if !g.NewScalar().SetBytesReduced(twice).IsZero() {
+		t.Fatal(errExpectedIdentity)
+	}
+
+	// order + 1 reduces to 1.
+	plusOne := new(big.Int).Add(order, big.NewInt(1)).Bytes()
+	if !g.NewScalar().SetBytesReduced(plusOne).Equal(g.NewScalar().One()) {
+		t.Fatal(errExpectedEquality)
+	}
+}
+
 func scalarTestAdd(t *testing.T, g ecc.Group) {
 	r := g.NewScalar().Random()
 	cpy := r.Copy()
@@ -406,6 +656,73 @@ func scalarTestMultiply(t *testing.T, g ecc.Group) {
 	}
 }
 
+func scalarTestMultiplyAdd(t *testing.T, g ecc.Group) {
+	s := g.NewScalar().Random()
+	a := g.NewScalar().Random()
+	b := g.NewScalar().Random()
+	expected := s.Copy().Multiply(a).Add(b)
+
+	if !s.Copy().MultiplyAdd(a, b).Equal(expected) {
+		t.Fatal("expected s*a + b")
+	}
+
+	// s*nil + b = b
+	if !s.Copy().MultiplyAdd(nil, b).Equal(b) {
+		t.Fatal("expected s*nil + b = b")
+	}
+
+	// s*nil + nil = 0
+	if !s.Copy().MultiplyAdd(nil, nil).IsZero() {
+		t.Fatal("expected s*nil + nil = 0")
+	}
+
+	// s*a + nil = s*a
+	if !s.Copy().MultiplyAdd(a, nil).Equal(s.Copy().Multiply(a)) {
+		t.Fatal("expected s*a + nil = s*a")
+	}
+
+	// Horner's method: 2*x^2 + 3*x + 5 evaluated at x = 7 is 124.
+	x := g.NewScalar().SetUInt64(7)
+	acc := g.NewScalar().SetUInt64(2)
+	acc.MultiplyAdd(x, g.NewScalar().SetUInt64(3))
+	acc.MultiplyAdd(x, g.NewScalar().SetUInt64(5))
+
+	if !acc.Equal(g.NewScalar().SetUInt64(124)) {
+		t.Fatal("expected 2*7^2 + 3*7 + 5 = 124")
+	}
+
+	// Aliasing the receiver.
+	s = g.NewScalar().Random()
+	expected = s.Copy().Multiply(s).Add(s)
+
+	if !s.MultiplyAdd(s, s).Equal(expected) {
+		t.Fatal("expected s*s + s")
+	}
+}
+
+func scalarTestUint64Arithmetic(t *testing.T, g ecc.Group) {
+	for _, n := range []uint64{0, 1, 2, 255, math.MaxUint32, math.MaxUint64} {
+		for _, s := range []*ecc.Scalar{g.NewScalar().Zero(), g.NewScalar().MinusOne(), g.NewScalar().Random()} {
+			if !s.Copy().AddUint64(n).Equal(s.Copy().Add(g.NewScalar().SetUInt64(n))) {
+				t.Fatalf("expected AddUint64(%d) to match Add", n)
+			}
+
+			if !s.Copy().MulUint64(n).Equal(s.Copy().Multiply(g.NewScalar().SetUInt64(n))) {
+				t.Fatalf("expected MulUint64(%d) to match Multiply", n)
+			}
+		}
+	}
+
+	// -1 + 1 = 0, and -1 * 2 = -2.
+	if !g.NewScalar().MinusOne().AddUint64(1).IsZero() {
+		t.Fatal("expected -1 + 1 = 0")
+	}
+
+	if !g.NewScalar().MinusOne().MulUint64(2).Equal(g.NewScalar().MinusOne().Add(g.NewScalar().MinusOne())) {
+		t.Fatal("expected -1 * 2 = -2")
+	}
+}
+
 func scalarTestPow(t *testing.T, g ecc.Group) {
 	// s**nil = 1
 	s := g.NewScalar().Random()
@@ -492,7 +809,8 @@ func scalarTestPow(t *testing.T, g ecc.Group) {
 
 	switch g {
 	// These are in little-endian
-	case ecc.Ristretto255Sha512, ecc.Edwards25519Sha512:
+	case ecc.Ristretto255Sha512, ecc.Decaf448Shake256, ecc.Edwards25519Sha512, ecc.Edwards448Shake256,
+		ecc.PallasSha256, ecc.VestaSha256:
 		e := s.Encode()
 		for i, j := 0, len(e)-1; i < j; i++ {
 			e[i], e[j] = e[j], e[i]
@@ -519,20 +837,107 @@ func scalarTestPow(t *testing.T, g ecc.Group) {
 	}
 }
 
-func bigIntExp(t *testing.T, g ecc.Group, base, exp *big.Int) *ecc.Scalar {
-	orderBytes := g.Order()
+func scalarTestPowUint64(t *testing.T, g ecc.Group) {
+	// s**0 = 1
+	s := g.NewScalar().Random()
+	if !s.PowUint64(0).Equal(g.NewScalar().One()) {
+		t.Fatal("expected s**0 = 1")
+	}
 
-	if g == ecc.Ristretto255Sha512 || g == ecc.Edwards25519Sha512 {
-		slices.Reverse(orderBytes)
+	// s**1 = s
+	s = g.NewScalar().Random()
+	if !s.Copy().PowUint64(1).Equal(s) {
+		t.Fatal("expected s**1 = s")
 	}
 
-	order := new(big.Int).SetBytes(orderBytes)
-	r := new(big.Int).Exp(base, exp, order)
+	// s**2 = s*s
+	s = g.NewScalar().Random()
+	if !s.Copy().PowUint64(2).Equal(s.Copy().Multiply(s)) {
+		t.Fatal("expected s**2 = s*s")
+	}
+
+	// 5**7 = 78125
+	if !g.NewScalar().SetUInt64(5).PowUint64(7).Equal(g.NewScalar().SetUInt64(78125)) {
+		t.Fatal("expected 5**7 = 78125")
+	}
+
+	// 3**255
+	result := bigIntExp(t, g, big.NewInt(3), big.NewInt(255))
+	if !g.NewScalar().SetUInt64(3).PowUint64(255).Equal(result) {
+		t.Fatal("expected equality on 3**255")
+	}
+
+	// Must match Pow for random bases and exponents.
+	exponents := []uint64{0, 1, 2, 3, 7, 513, math.MaxUint32, math.MaxUint64}
+	for _, e := range exponents {
+		s = g.NewScalar().Random()
+		exp := g.NewScalar().SetUInt64(e)
+
+		if !s.Copy().PowUint64(e).Equal(s.Copy().Pow(exp)) {
+			t.Fatalf("expected PowUint64(%d) to match Pow", e)
+		}
+	}
+}
+
+func scalarTestPowZeroBase(t *testing.T, g ecc.Group) {
+	one := g.NewScalar().One()
+
+	// 0**0 = 1
+	if !g.NewScalar().Zero().Pow(g.NewScalar().Zero()).Equal(one) {
+		t.Fatal("expected 0**0 = 1")
+	}
+
+	if !g.NewScalar().Zero().PowUint64(0).Equal(one) {
+		t.Fatal("expected 0**0 = 1")
+	}
+
+	// 0**nil = 1
+	if !g.NewScalar().Zero().Pow(nil).Equal(one) {
+		t.Fatal("expected 0**nil = 1")
+	}
+
+	// 0**1 = 0
+	if !g.NewScalar().Zero().Pow(g.NewScalar().One()).IsZero() {
+		t.Fatal("expected 0**1 = 0")
+	}
+
+	// 0**5 = 0
+	if !g.NewScalar().Zero().Pow(g.NewScalar().SetUInt64(5)).IsZero() {
+		t.Fatal("expected 0**5 = 0")
+	}
+
+	if !g.NewScalar().Zero().PowUint64(5).IsZero() {
+		t.Fatal("expected 0**5 = 0")
+	}
+
+	// 0**random = 0
+	if !g.NewScalar().Zero().Pow(g.NewScalar().Random()).IsZero() {
+		t.Fatal("expected 0**k = 0")
+	}
+
+	// The zero base and zero exponent shortcuts don't skip the check of the exponent's group.
+	var wrongGroup ecc.Group = ecc.Ristretto255Sha512
+	if g == ecc.Ristretto255Sha512 {
+		wrongGroup = ecc.P256Sha256
+	}
+
+	for _, exp := range []*ecc.Scalar{wrongGroup.NewScalar().Random(), wrongGroup.NewScalar().Zero()} {
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			g.NewScalar().Zero().Pow(exp)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func bigIntExp(t *testing.T, g ecc.Group, base, exp *big.Int) *ecc.Scalar {
+	r := new(big.Int).Exp(base, exp, g.OrderBigInt())
 
 	b := make([]byte, g.ScalarLength())
 	r.FillBytes(b)
 
-	if g == ecc.Ristretto255Sha512 || g == ecc.Edwards25519Sha512 {
+	if g == ecc.Ristretto255Sha512 || g == ecc.Decaf448Shake256 || g == ecc.Edwards25519Sha512 ||
+		g == ecc.Edwards448Shake256 || g == ecc.PallasSha256 || g == ecc.VestaSha256 {
 		slices.Reverse(b)
 	}
 
@@ -559,4 +964,254 @@ func scalarTestInvert(t *testing.T, g ecc.Group) {
 	if !s.One().Equal(square.Multiply(inv)) {
 		t.Fatal(errExpectedEquality)
 	}
+
+	// s * 1/s = 1 for random and edge-case scalars.
+	scalars := []*ecc.Scalar{g.NewScalar().One(), g.NewScalar().SetUInt64(2), g.NewScalar().MinusOne()}
+	for range 64 {
+		scalars = append(scalars, g.NewScalar().Random())
+	}
+
+	one := g.NewScalar().One()
+	for _, s := range scalars {
+		if !s.Copy().Invert().Multiply(s).Equal(one) {
+			t.Fatalf("expected s * 1/s = 1 for %s", s.Hex())
+		}
+	}
+
+	// The inverse of 0 is 0, including for a scalar zeroed after holding another value.
+	if !g.NewScalar().Invert().IsZero() || !g.NewScalar().Zero().Invert().IsZero() {
+		t.Fatal("expected the inverse of zero to be zero")
+	}
+
+	zero := g.NewScalar().Random().Zero()
+	if !zero.Invert().IsZero() || zero.Group() != g {
+		t.Fatal("expected the inverse of zero to be zero")
+	}
+
+	if !g.NewScalar().Zero().Invert().Invert().IsZero() {
+		t.Fatal("expected inverting zero twice to be zero")
+	}
+}
+
+func scalarTestHalve(t *testing.T, g ecc.Group) {
+	two := g.NewScalar().SetUInt64(2)
+	inverseOfTwo := two.Copy().Invert()
+
+	scalars := []*ecc.Scalar{
+		g.NewScalar().Zero(), g.NewScalar().One(), two, g.NewScalar().SetUInt64(255), g.NewScalar().MinusOne(),
+	}
+	for range 32 {
+		scalars = append(scalars, g.NewScalar().Random())
+	}
+
+	for _, s := range scalars {
+		h := s.Copy().Halve()
+
+		// h + h = s
+		if !h.Copy().Add(h).Equal(s) {
+			t.Fatalf("expected 2 * (s / 2) = s for %s", s.Hex())
+		}
+
+		if !h.Equal(s.Copy().Multiply(inverseOfTwo)) {
+			t.Fatalf("expected s / 2 = s * 1/2 for %s", s.Hex())
+		}
+	}
+
+	// 1 / 2 = (order + 1) / 2, and 2 / 2 = 1.
+	expected := new(big.Int).Add(g.OrderBigInt(), big.NewInt(1))
+	if scalarToBigInt(g, g.NewScalar().One().Halve()).Cmp(expected.Rsh(expected, 1)) != 0 {
+		t.Fatal("expected 1 / 2 = (order + 1) / 2")
+	}
+
+	if !two.Copy().Halve().Equal(g.NewScalar().One()) {
+		t.Fatal("expected 2 / 2 = 1")
+	}
+}
+
+func scalarTestCondNegate(t *testing.T, g ecc.Group) {
+	scalars := []*ecc.Scalar{g.NewScalar().Zero(), g.NewScalar().One(), g.NewScalar().MinusOne()}
+	for range 8 {
+		scalars = append(scalars, g.NewScalar().Random())
+	}
+
+	for _, s := range scalars {
+		if !s.Copy().CondNegate(0).Equal(s) {
+			t.Fatalf("expected %s to be unchanged for choice 0", s.Hex())
+		}
+
+		if !s.Copy().CondNegate(1).Equal(g.NewScalar().Subtract(s)) {
+			t.Fatalf("expected %s to be negated for choice 1", s.Hex())
+		}
+	}
+}
+
+func scalarTestActOnBase(t *testing.T, g ecc.Group) {
+	for _, s := range []*ecc.Scalar{g.NewScalar().One(), g.NewScalar().MinusOne(), g.NewScalar().Random()} {
+		sCopy := s.Copy()
+
+		if !s.ActOnBase().Equal(g.Base().Multiply(s)) {
+			t.Fatalf("expected ActOnBase to match Base().Multiply for %s", s.Hex())
+		}
+
+		if !s.Equal(sCopy) {
+			t.Fatal("unexpected modification of the scalar")
+		}
+	}
+
+	if !g.NewScalar().ActOnBase().IsIdentity() {
+		t.Fatal("expected the identity for a zero scalar")
+	}
+}
+
+func scalarTestCSwap(t *testing.T, g ecc.Group) {
+	a, b := g.NewScalar().Random(), g.NewScalar().Random()
+	aCopy, bCopy := a.Copy(), b.Copy()
+
+	a.CSwap(b, 0)
+	if !a.Equal(aCopy) || !b.Equal(bCopy) {
+		t.Fatal("expected no swap for choice 0")
+	}
+
+	a.CSwap(b, 1)
+	if !a.Equal(bCopy) || !b.Equal(aCopy) {
+		t.Fatal("expected a swap for choice 1")
+	}
+
+	a.CSwap(b, 1)
+	if !a.Equal(aCopy) || !b.Equal(bCopy) {
+		t.Fatal("expected two swaps to restore the scalars")
+	}
+
+	if err := testPanic("nil scalar", internal.ErrParamNilScalar, func() { a.CSwap(nil, 1) }); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func scalarTestPowBig(t *testing.T, g ecc.Group) {
+	order := g.OrderBigInt()
+	orderMinusOne := new(big.Int).Sub(order, big.NewInt(1))
+
+	// Exponents above the order, up to a few times its size.
+	exponents := []*big.Int{
+		big.NewInt(1), big.NewInt(2), orderMinusOne, order, new(big.Int).Add(order, big.NewInt(1)),
+		new(big.Int).Mul(order, order), new(big.Int).Lsh(big.NewInt(1), uint(4*order.BitLen())),
+	}
+
+	for range 4 {
+		e, err := rand.Int(rand.Reader, new(big.Int).Lsh(order, 256))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		exponents = append(exponents, e)
+	}
+
+	for range 4 {
+		base := g.NewScalar().Random()
+		b := scalarToBigInt(g, base)
+
+		for _, e := range exponents {
+			expected := new(big.Int).Exp(b, e, order)
+			if scalarToBigInt(g, base.Copy().PowBig(e)).Cmp(expected) != 0 {
+				t.Fatalf("expected %s^%s = %s", b, e, expected)
+			}
+
+			// A negative exponent is the exponent of the inverse.
+			expected.ModInverse(expected, order)
+			if scalarToBigInt(g, base.Copy().PowBig(new(big.Int).Neg(e))).Cmp(expected) != 0 {
+				t.Fatalf("expected %s^-%s = %s", b, e, expected)
+			}
+		}
+
+		one := g.NewScalar().One()
+		if !base.Copy().PowBig(big.NewInt(0)).Equal(one) || !base.Copy().PowBig(nil).Equal(one) {
+			t.Fatal("expected s^0 = 1")
+		}
+	}
+
+	// 0^k = 0 for k != 0, including multiples of order - 1, and 0^0 = 1.
+	for _, e := range []*big.Int{big.NewInt(1), orderMinusOne, new(big.Int).Neg(orderMinusOne)} {
+		if !g.NewScalar().PowBig(e).IsZero() {
+			t.Fatalf("expected 0^%s = 0", e)
+		}
+	}
+
+	if !g.NewScalar().PowBig(big.NewInt(0)).Equal(g.NewScalar().One()) {
+		t.Fatal("expected 0^0 = 1")
+	}
+}
+
+func scalarTestSetBigInt(t *testing.T, g ecc.Group) {
+	order := g.OrderBigInt()
+	values := []*big.Int{
+		big.NewInt(0), big.NewInt(1), big.NewInt(-1), new(big.Int).Sub(order, big.NewInt(1)), order,
+		new(big.Int).Add(order, big.NewInt(5)), new(big.Int).Neg(new(big.Int).Mul(order, big.NewInt(3))),
+		new(big.Int).Lsh(big.NewInt(1), 512),
+	}
+
+	for _, v := range values {
+		expected := new(big.Int).Mod(v, order)
+		if scalarToBigInt(g, g.NewScalar().Random().SetBigInt(v)).Cmp(expected) != 0 {
+			t.Fatalf("expected SetBigInt(%s) = %s", v, expected)
+		}
+	}
+
+	// A negative value yields the negation of its absolute value.
+	v := new(big.Int).Lsh(big.NewInt(1), 300)
+	if !g.NewScalar().SetBigInt(new(big.Int).Neg(v)).Add(g.NewScalar().SetBigInt(v)).IsZero() {
+		t.Fatal("expected SetBigInt(-v) = -SetBigInt(v)")
+	}
+
+	if !g.NewScalar().Random().SetBigInt(nil).IsZero() {
+		t.Fatal("expected SetBigInt(nil) = 0")
+	}
+}
+
+func scalarTestInto(t *testing.T, g ecc.Group) {
+	for _, test := range []struct {
+		into func(dst, a, b *ecc.Scalar) *ecc.Scalar
+		op   func(a, b *ecc.Scalar) *ecc.Scalar
+		name string
+	}{
+		{(*ecc.Scalar).AddInto, ecc.AddScalars, "AddInto"},
+		{(*ecc.Scalar).MultiplyInto, ecc.MulScalars, "MultiplyInto"},
+	} {
+		a, b := g.NewScalar().Random(), g.NewScalar().Random()
+		aCopy, bCopy := a.Copy(), b.Copy()
+		expected := test.op(a, b)
+
+		dst := g.NewScalar().Random()
+		if test.into(dst, a, b) != dst || !dst.Equal(expected) || !a.Equal(aCopy) || !b.Equal(bCopy) {
+			t.Fatalf("%s: unexpected result or modification of the operands", test.name)
+		}
+
+		// Aliasing of the receiver with either operand, or both.
+		if !test.into(a, a, b).Equal(expected) || !b.Equal(bCopy) {
+			t.Fatalf("%s: unexpected result for dst == a", test.name)
+		}
+
+		a.Set(aCopy)
+		if !test.into(b, a, b).Equal(expected) || !a.Equal(aCopy) {
+			t.Fatalf("%s: unexpected result for dst == b", test.name)
+		}
+
+		if !test.into(a, a, a).Equal(test.op(aCopy, aCopy)) {
+			t.Fatalf("%s: unexpected result for dst == a == b", test.name)
+		}
+
+		if err := testPanic("nil scalar", internal.ErrParamNilScalar, func() { test.into(dst, nil, b) }); err != nil {
+			t.Fatal(err)
+		}
+
+		var wrongGroup ecc.Group = ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		if err := testPanic("wrong group", internal.ErrCastScalar, func() {
+			test.into(wrongGroup.NewScalar(), a, b)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
 }
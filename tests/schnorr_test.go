@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+func TestSchnorr(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		schnorr := ecc.NewSchnorr(group.group)
+		secret := group.group.NewScalar().Random()
+		pub := group.group.Base().Multiply(secret)
+		message := []byte("message")
+
+		sig, err := schnorr.Sign(secret, message)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sig) != schnorr.SignatureLength() {
+			t.Fatalf("unexpected signature length %d", len(sig))
+		}
+
+		if !schnorr.Verify(pub, message, sig) {
+			t.Fatal("signature verification failed")
+		}
+
+		// Signatures are randomized.
+		sig2, err := schnorr.Sign(secret, message)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Equal(sig, sig2) || !schnorr.Verify(pub, message, sig2) {
+			t.Fatal("unexpected second signature")
+		}
+
+		if schnorr.Verify(pub, []byte("other message"), sig) {
+			t.Fatal("expected failure on another message")
+		}
+
+		if schnorr.Verify(group.group.Base(), message, sig) {
+			t.Fatal("expected failure on another public key")
+		}
+
+		if schnorr.Verify(group.group.NewElement(), message, sig) || schnorr.Verify(nil, message, sig) {
+			t.Fatal("expected failure on invalid public key")
+		}
+
+		if schnorr.Verify(pub, message, sig[:len(sig)-1]) {
+			t.Fatal("expected failure on short signature")
+		}
+
+		// A different response must fail.
+		z := group.group.NewScalar()
+		if err = z.Decode(sig[group.group.ElementLength():]); err != nil {
+			t.Fatal(err)
+		}
+
+		tampered := append(bytes.Clone(sig[:group.group.ElementLength()]), z.Add(z.Copy().One()).Encode()...)
+		if schnorr.Verify(pub, message, tampered) {
+			t.Fatal("expected failure on tampered signature")
+		}
+	})
+}
+
+func TestSchnorr_Sign_Fails(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		schnorr := ecc.NewSchnorr(group.group)
+
+		if _, err := schnorr.Sign(nil, nil); !errors.Is(err, internal.ErrParamNilScalar) {
+			t.Fatalf("expected nil scalar error, got %v", err)
+		}
+
+		if _, err := schnorr.Sign(group.group.NewScalar(), nil); !errors.Is(err, internal.ErrParamNilScalar) {
+			t.Fatalf("expected nil scalar error, got %v", err)
+		}
+
+		other := ecc.Ristretto255Sha512
+		if group.group == other {
+			other = ecc.P256Sha256
+		}
+
+		if _, err := schnorr.Sign(other.NewScalar().Random(), nil); !errors.Is(err, internal.ErrCastScalar) {
+			t.Fatalf("expected cast error, got %v", err)
+		}
+	})
+}
+
+func TestSchnorr_VerifyBatch(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		schnorr := ecc.NewSchnorr(group.group)
+		n := 5
+		pubs := make([]*ecc.Element, n)
+		messages := make([][]byte, n)
+		signatures := make([][]byte, n)
+
+		for i := range n {
+			secret := group.group.NewScalar().Random()
+			pubs[i] = group.group.Base().Multiply(secret)
+			messages[i] = []byte(fmt.Sprintf("message %d", i))
+
+			sig, err := schnorr.Sign(secret, messages[i])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			signatures[i] = sig
+		}
+
+		if !schnorr.VerifyBatch(pubs, messages, signatures) {
+			t.Fatal("batch verification failed")
+		}
+
+		if schnorr.VerifyBatch(nil, nil, nil) || schnorr.VerifyBatch(pubs, messages[1:], signatures) {
+			t.Fatal("expected failure on invalid input lengths")
+		}
+
+		// Swapping two signatures must fail, and single verification tells which ones are invalid.
+		signatures[1], signatures[3] = signatures[3], signatures[1]
+		if schnorr.VerifyBatch(pubs, messages, signatures) {
+			t.Fatal("expected batch verification failure")
+		}
+
+		for i := range n {
+			if schnorr.Verify(pubs[i], messages[i], signatures[i]) != (i != 1 && i != 3) {
+				t.Fatalf("unexpected verification result for signature %d", i)
+			}
+		}
+	})
+}
@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+	"github.com/0xBridge/ecc/sss"
+)
+
+func TestShamir(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		for _, params := range [][2]int{{1, 1}, {1, 3}, {2, 3}, {3, 5}, {5, 5}, {4, 10}} {
+			threshold, total := params[0], params[1]
+			secret := group.group.NewScalar().Random()
+			shares := sss.Split(secret, threshold, total)
+
+			if len(shares) != total {
+				t.Fatalf("expected %d shares, got %d", total, len(shares))
+			}
+
+			for i, share := range shares {
+				if share.Index != uint64(i)+1 {
+					t.Fatalf("unexpected index %d for share %d", share.Index, i)
+				}
+			}
+
+			// Any random subset of at least threshold shares recovers the secret.
+			for range 10 {
+				subset := make([]sss.Share, total)
+				copy(subset, shares)
+				rand.Shuffle(len(subset), func(i, j int) { subset[i], subset[j] = subset[j], subset[i] })
+				subset = subset[:threshold+rand.IntN(total-threshold+1)]
+
+				if !sss.Recover(subset).Equal(secret) {
+					t.Fatalf("(%d, %d): failed to recover the secret from %d shares", threshold, total, len(subset))
+				}
+
+				if threshold > 1 && sss.Recover(subset[:threshold-1]).Equal(secret) {
+					t.Fatalf("(%d, %d): recovered the secret below the threshold", threshold, total)
+				}
+			}
+		}
+	})
+}
+
+func TestShamir_Panics(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		secret := group.group.NewScalar().Random()
+
+		if err := testPanic("nil secret", internal.ErrParamNilScalar, func() {
+			_ = sss.Split(nil, 2, 3)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		threshold := errors.New("threshold must be positive and at most the number of shares")
+		for _, params := range [][2]int{{0, 3}, {-1, 3}, {4, 3}} {
+			if err := testPanic("invalid threshold", threshold, func() {
+				_ = sss.Split(secret, params[0], params[1])
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		shares := sss.Split(secret, 2, 3)
+
+		if err := testPanic("no shares", errors.New("no shares to recover from"), func() {
+			_ = sss.Recover(nil)
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("duplicate index", errors.New("duplicate share index"), func() {
+			_ = sss.Recover([]sss.Share{shares[0], shares[1], shares[0]})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("zero index", errors.New("share index must not be zero"), func() {
+			_ = sss.Recover([]sss.Share{shares[0], {Value: shares[1].Value, Index: 0}})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := testPanic("nil value", internal.ErrParamNilScalar, func() {
+			_ = sss.Recover([]sss.Share{shares[0], {Index: 2}})
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		other := ecc.Ristretto255Sha512
+		if group.group == other {
+			other = ecc.P256Sha256
+		}
+
+		if err := testPanic("other group", internal.ErrCastScalar, func() {
+			_ = sss.Recover([]sss.Share{shares[0], {Value: other.NewScalar().Random(), Index: 2}})
+		}); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestShamir_Verifiable(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		secret := group.group.NewScalar().Random()
+		shares, commitments := sss.SplitVerifiable(secret, 3, 5)
+
+		if len(commitments) != 3 {
+			t.Fatalf("expected 3 commitments, got %d", len(commitments))
+		}
+
+		if !commitments[0].Equal(group.group.ScalarBaseMult(secret)) {
+			t.Fatal("the first commitment must be the public key of the secret")
+		}
+
+		for _, share := range shares {
+			if !sss.VerifyShare(share, commitments) {
+				t.Fatalf("valid share %d failed verification", share.Index)
+			}
+		}
+
+		if !sss.Recover(shares[1:4]).Equal(secret) {
+			t.Fatal("failed to recover the secret")
+		}
+
+		// Tampered values, indexes, or commitments must fail.
+		tampered := sss.Share{Value: shares[0].Value.Copy().Add(group.group.NewScalar().One()), Index: shares[0].Index}
+		if sss.VerifyShare(tampered, commitments) {
+			t.Fatal("expected failure on tampered value")
+		}
+
+		if sss.VerifyShare(sss.Share{Value: shares[0].Value, Index: 2}, commitments) {
+			t.Fatal("expected failure on tampered index")
+		}
+
+		other := make([]*ecc.Element, len(commitments))
+		copy(other, commitments)
+		other[2] = other[2].Copy().Add(group.group.Base())
+
+		if sss.VerifyShare(shares[0], other) {
+			t.Fatal("expected failure on tampered commitments")
+		}
+
+		if sss.VerifyShare(shares[0], commitments[:2]) {
+			t.Fatal("expected failure on missing commitments")
+		}
+
+		if sss.VerifyShare(shares[0], nil) || sss.VerifyShare(sss.Share{Index: 1}, commitments) ||
+			sss.VerifyShare(sss.Share{Value: shares[0].Value}, commitments) {
+			t.Fatal("expected failure on invalid input")
+		}
+
+		other[2] = nil
+		if sss.VerifyShare(shares[0], other) {
+			t.Fatal("expected failure on nil commitment")
+		}
+	})
+}
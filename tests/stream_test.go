@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"testing/iotest"
+)
+
+func TestGroup_HashToGroupReader(t *testing.T) {
+	inputs := [][]byte{nil, testHashToGroupInput, bytes.Repeat([]byte("large input"), 10000)}
+	dsts := [][]byte{testHashToGroupDST, bytes.Repeat([]byte("oversize DST"), 25)}
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		for _, dst := range dsts {
+			for i, input := range inputs {
+				e, err := g.HashToGroupReader(iotest.OneByteReader(bytes.NewReader(input)), dst)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !e.Equal(g.HashToGroup(input, dst)) {
+					t.Fatalf("expected HashToGroupReader to match HashToGroup for input %d and DST length %d", i,
+						len(dst))
+				}
+
+				s, err := g.HashToScalarReader(bytes.NewReader(input), dst)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !s.Equal(g.HashToScalar(input, dst)) {
+					t.Fatalf("expected HashToScalarReader to match HashToScalar for input %d and DST length %d", i,
+						len(dst))
+				}
+			}
+		}
+
+		// The hash-to-curve test vector.
+		e, err := g.HashToGroupReader(bytes.NewReader(group.hashToCurve.input), group.hashToCurve.dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !e.Equal(decodeElement(t, g, group.hashToCurve.hashToGroup)) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestGroup_HashToGroupReader_Errors(t *testing.T) {
+	errRead := errors.New("read error")
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+
+		if _, err := g.HashToGroupReader(iotest.ErrReader(errRead), testHashToGroupDST); !errors.Is(err, errRead) {
+			t.Fatalf("expected %q, got %v", errRead, err)
+		}
+
+		if _, err := g.HashToScalarReader(iotest.ErrReader(errRead), testHashToGroupDST); !errors.Is(err, errRead) {
+			t.Fatalf("expected %q, got %v", errRead, err)
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_, _ = g.HashToGroupReader(bytes.NewReader(testHashToGroupInput), nil)
+		}); err != nil {
+			t.Error(fmt.Errorf(errWrapGroup, errNoPanic, err))
+		}
+
+		if err := testPanic("nil dst", errZeroLenDST, func() {
+			_, _ = g.HashToScalarReader(bytes.NewReader(testHashToGroupInput), nil)
+		}); err != nil {
+			t.Error(fmt.Errorf(errWrapGroup, errNoPanic, err))
+		}
+	})
+}
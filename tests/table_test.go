@@ -23,6 +23,8 @@ func testAllGroups(t *testing.T, f func(*testGroup)) {
 	}
 }
 
+const decafBasePoint = "6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333"
+
 var (
 	testHashToGroupInput = []byte("input data")
 	testHashToGroupDST   = []byte("domain separation tag")
@@ -91,6 +93,43 @@ var testTable = []*testGroup{
 		group:         1,
 		hash:          crypto.SHA512,
 	},
+	{
+		multBase: [15]string{
+			"6666666666666666666666666666666666666666666666666666666633333333333333333333333333333333333333333333333333333333",
+			"c898eb4f87f97c564c6fd61fc7e49689314a1f818ec85eeb3bd5514ac816d38778f69ef347a89fca817e66defdedce178c7cc709b2116e75",
+			"a0c09bf2ba7208fda0f4bfe3d0f5b29a543012306d43831b5adc6fe7f8596fa308763db15468323b11cf6e4aeb8c18fe44678f44545a69bc",
+			"b46f1836aa287c0a5a5653f0ec5ef9e903f436e21c1570c29ad9e5f596da97eeaf17150ae30bcb3174d04bc2d712c8c7789d7cb4fda138f4",
+			"1c5bbecf4741dfaae79db72dface00eaaac502c2060934b6eaaeca6a20bd3da9e0be8777f7d02033d1b15884232281a41fc7f80eed04af5e",
+			"86ff0182d40f7f9edb7862515821bd67bfd6165a3c44de95d7df79b8779ccf6460e3c68b70c16aaa280f2d7b3f22d745b97a89906cfc476c",
+			"502bcb6842eb06f0e49032bae87c554c031d6d4d2d7694efbf9c468d48220c50f8ca28843364d70cee92d6fe246e61448f9db9808b3b2408",
+			"0c9810f1e2ebd389caa789374d78007974ef4d17227316f40e578b336827da3f6b482a4794eb6a3975b971b5e1388f52e91ea2f1bcb0f912",
+			"20d41d85a18d5657a29640321563bbd04c2ffbd0a37a7ba43a4f7d263ce26faf4e1f74f9f4b590c69229ae571fe37fa639b5b8eb48bd9a55",
+			"e6b4b8f408c7010d0601e7eda0c309a1a42720d6d06b5759fdc4e1efe22d076d6c44d42f508d67be462914d28b8edce32e7094305164af17",
+			"be88bbb86c59c13d8e9d09ab98105f69c2d1dd134dbcd3b0863658f53159db64c0e139d180f3c89b8296d0ae324419c06fa87fc7daaf34c1",
+			"a456f9369769e8f08902124a0314c7a06537a06e32411f4f93415950a17badfa7442b6217434a3a05ef45be5f10bd7b2ef8ea00c431edec5",
+			"186e452c4466aa4383b4c00210d52e7922dbf9771e8b47e229a9b7b73c8d10fd7ef0b6e41530f91f24a3ed9ab71fa38b98b2fe4746d51d68",
+			"4ae7fdcae9453f195a8ead5cbe1a7b9699673b52c40ab27927464887be53237f7f3a21b938d40d0ec9e15b1d5130b13ffed81373a53e2b43",
+			"841981c3bfeec3f60cfeca75d9d8dc17f46cf0106f2422b59aec580a58f342272e3a5e575a055ddb051390c54c24c6ecb1e0aceb075f6056",
+		},
+		name:       "Decaf448",
+		h2c:        "decaf448_XOF:SHAKE256_D448MAP_RO_",
+		e2c:        "decaf448_XOF:SHAKE256_D448MAP_RO_",
+		basePoint:  decafBasePoint,
+		basePointX: decafBasePoint,
+		identity:   "0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		fieldOrder: "726838724295606890549323807888004534353641360687318060281490199180612328166730772686396383698676545930088884461843637361053498018365439",
+		groupOrder: "f34458ab92c27823558fc58d72c26c219036d6ae49db4ec4e923ca7cffffffffffffffffffffffffffffffffffffffffffffffffffffff3f",
+		hashToCurve: testHashToCurve{
+			input:        testHashToGroupInput,
+			dst:          testHashToGroupDST,
+			hashToScalar: "3aa8c83a511d98ccc7e16e0910c1644fa020ded6cae4b606a3c26e412034fa783bff6301c81fbad592d9e52ff91b707521fcbb6345caf201",
+			hashToGroup:  "d603c7fe23e21d63221901ebcb7a919c13d9083c6c4fd5e7c5ab9b4b223ccd884e71ac0b985658a3cfee45796e79ed0ba9ecef93e83a3e1b",
+		},
+		elementLength: 56,
+		scalarLength:  56,
+		group:         2,
+		hash:          crypto.SHA512,
+	},
 	{
 		multBase: [15]string{
 			"036b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c296",
@@ -276,4 +315,189 @@ var testTable = []*testGroup{
 		group:         7,
 		hash:          crypto.SHA256,
 	},
+	{
+		multBase: [15]string{
+			"02b70e0cbd6bb4bf7f321390b94a03c1d356c21122343280d6115c1d21",
+			"03706a46dc76dcb76798e60e6d89474788d16dc18032d268fd1a704fa6",
+			"03df1b1d66a551d0d31eff822558b9d2cc75c2180279fe0d08fd896d04",
+			"03ae99feebb5d26945b54892092a8aee02912930fa41cd114e40447301",
+			"0331c49ae75bce7807cdff22055d94ee9021fedbb5ab51c57526f011aa",
+			"021f2483f82572251fca975fea40db821df8ad82a3c002ee6c57112408",
+			"03db2f6be630e246a5cf7d99b85194b123d487e2d466b94b24a03c3e28",
+			"02858e6f9cc6c12c31f5df124aa77767b05c8bc021bd683d2b55571550",
+			"032fdcccfee720a77ef6cb3bfbb447f9383117e3daa4a07e36ed15f78d",
+			"03aea9e17a306517eb89152aa7096d2c381ec813c51aa880e7bee2c0fd",
+			"02ef53b6294aca431f0f3c22dc82eb9050324f1d88d377e716448e507c",
+			"036e31ee1dc137f81b056752e4deab1443a481033e9b4c93a3044f4f7a",
+			"0334e8e17a430e43289793c383fac9774247b40e9ebd3366981fcfaeca",
+			"03a53640c83dc208603ded83e4ecf758f24c357d7cf48088b2ce01e9fa",
+			"03baa4d8635511a7d288aebeedd12ce529ff102c91f97f867e21916bf9",
+		},
+		name:       "P224",
+		h2c:        "P224_XMD:SHA-256_SSWU_RO_",
+		e2c:        "P224_XMD:SHA-256_SSWU_NU_",
+		basePoint:  "02b70e0cbd6bb4bf7f321390b94a03c1d356c21122343280d6115c1d21",
+		basePointX: "b70e0cbd6bb4bf7f321390b94a03c1d356c21122343280d6115c1d21",
+		identity:   "0000000000000000000000000000000000000000000000000000000000",
+		fieldOrder: "26959946667150639794667015087019630673557916260026308143510066298881",
+		groupOrder: "ffffffffffffffffffffffffffff16a2e0b8f03e13dd29455c5c2a3d",
+		hashToCurve: testHashToCurve{
+			input:        testHashToGroupInput,
+			dst:          testHashToGroupDST,
+			hashToScalar: "d1dfa787dfeb2ba12b7136985d0d3097878a142fe1e492b361de59f3",
+			hashToGroup:  "024a9255b9a90c3ca4d161b56c15e556cac61312e13eba8686a33b672c",
+		},
+		elementLength: 29,
+		scalarLength:  28,
+		group:         8,
+		hash:          crypto.SHA256,
+	},
+	{
+		multBase: [15]string{
+			"14fa30f25b790898adc8d74e2c13bdfdc4397ce61cffd33ad7c2a0051e9c78874098a36c7373ea4b62c7c9563720768824bcb66e71463f6900",
+			"ed8693eacdfbeada6ba0cdd1beb2bcbb98302a3a8365650db8c4d88a726de3b7d74d8835a0d76e03b0c2865020d659b38d04d74a63e905ae80",
+			"fcd68e5813ac22b8af2dd0fe689afabff06767db1b333abb581d4eec823ce4fcb9c35623958d4a9a44a63ad47adacb06f75c12d5dba805e080",
+			"3918e56df836e2325b4f0d5d2844d4b57294caa17ef9d8c0c15b8a7b22c30dc945d857042fc0b79c971b02dea5334b1627e5cdace47790d400",
+			"eb35f4721473b44354221f88125540583cb3d259eea4d727710198b6f75165d8ce8fb13a82a10c26de0a58fde49c10b9d3ed17251a75fdad00",
+			"cc7b7ca01d5dea8ca98e9376e7cc3e2b1d864e28801bffe1b676de482285c56918127b1a5a76a253d0bf67c656c03e742d1cb68acdb9993f00",
+			"7f37acad3f0de2095c2b9766e834b7bb403c7a68d8c9842ffd46397b0ef578ca400ed079717e419475b88335b2bc73739c69fd903cdade7d80",
+			"9be069ce73b84e45eec84b1663169fd8a83b00f70882ad86d3ea984b7b4cd97b3bb9a4b3386b8c40ba46ff74351f7de8da9bea9b4e4f56c700",
+			"4f75f474f64da78bfbf38eea2cf5401197422dc347bb6f25da151c39715660bafa65c1b99379688c51f24da8d5cb7aaf2dae128f29b6601580",
+			"67dfcde4894a95b1b6858d4281637e0cdcab1adc97193cf90b5dff683a29eef71b1dda1a3817ace818e6947efef0f12410e16b020bea2f4d80",
+			"01af1660f8c90cab8c3dbf6a368812fe2e3aa1dd857406d005f60d39f787d106588ff1205d0cff00c9283317e0238130adfdf24b555bd34200",
+			"a71ed4c31c447ed1b2ebeb4f4cb92fb4c7a655c246a31dad4cdce2d749fda3239c84455806216364e752be0b564473ff033cca92ba3bd14d00",
+			"e579f48b6d5d53e9c34e6e536e15e29bdb1d74653136d79f15f78a98dd3de382a7d8130290e61442605468a204086ed5344c2ae5f0849bc580",
+			"7c80cf00c5ee170f47036cd9416576bfeaa092ad7c19f27d8ec211c9af717b1edcee80fc2b2204dd7171eb4cbdf667c864dbe42cae7ba26600",
+			"c3742085e065304e68a0a2b440fa55f963a72513850059f2c919efd45657669f869c799029312322057b02b88db2c286c90ade8af2b88ff480",
+		},
+		name:       "Edwards448",
+		h2c:        "edwards448_XOF:SHAKE256_ELL2_RO_",
+		e2c:        "edwards448_XOF:SHAKE256_ELL2_NU_",
+		basePoint:  "14fa30f25b790898adc8d74e2c13bdfdc4397ce61cffd33ad7c2a0051e9c78874098a36c7373ea4b62c7c9563720768824bcb66e71463f6900",
+		basePointX: "0500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		identity:   "010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		fieldOrder: "726838724295606890549323807888004534353641360687318060281490199180612328166730772686396383698676545930088884461843637361053498018365439",
+		groupOrder: "f34458ab92c27823558fc58d72c26c219036d6ae49db4ec4e923ca7cffffffffffffffffffffffffffffffffffffffffffffffffffffff3f00",
+		hashToCurve: testHashToCurve{
+			input:        testHashToGroupInput,
+			dst:          testHashToGroupDST,
+			hashToScalar: "beb1d019f5ae959bbbffdf6ac1e11ba96c5be3b5c3040c9267a01249fe30a919ad1e429da71972a80be26e259ab790c66b950ea45232043600",
+			hashToGroup:  "ea04647e60d0e1b40763a6cbd290228c15303a7ee62da61b2b122232c00229d43de6764daa952c88367bd6ca11329f497bcf728c1931018f00",
+		},
+		elementLength: 57,
+		scalarLength:  57,
+		group:         9,
+		hash:          crypto.SHA512,
+	},
+	{
+		multBase: [15]string{
+			"97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+			"a572cbea904d67468808c8eb50a9450c9721db309128012543902d0ac358a62ae28f75bb8f1c7c42c39a8c5529bf0f4e",
+			"89ece308f9d1f0131765212deca99697b112d61f9be9a5f1f3780a51335b3ff981747a0b2ca2179b96d2c0c9024e5224",
+			"ac9b60d5afcbd5663a8a44b7c5a02f19e9a77ab0a35bd65809bb5c67ec582c897feb04decc694b13e08587f3ff9b5b60",
+			"b0e7791fb972fe014159aa33a98622da3cdc98ff707965e536d8636b5fcc5ac7a91a8c46e59a00dca575af0f18fb13dc",
+			"a6e82f6da4520f85c5d27d8f329eccfa05944fd1096b20734c894966d12a9e2a9a9744529d7212d33883113a0cadb909",
+			"b928f3beb93519eecf0145da903b40a4c97dca00b21f12ac0df3be9116ef2ef27b2ae6bcd4c5bc2d54ef5a70627efcb7",
+			"a85ae765588126f5e860d019c0e26235f567a9c0c0b2d8ff30f3e8d436b1082596e5e7462d20f5be3764fd473e57f9cf",
+			"99cdf3807146e68e041314ca93e1fee0991224ec2a74beb2866816fd0826ce7b6263ee31e953a86d1b72cc2215a57793",
+			"af81da25ecf1c84b577fefbedd61077a81dc43b00304015b2b596ab67f00e41c86bb00ebd0f90d4b125eb0539891aeed",
+			"80fd75ebcc0a21649e3177bcce15426da0e4f25d6828fbf4038d4d7ed3bd4421de3ef61d70f794687b12b2d571971a55",
+			"8345dd80ffef0eaec8920e39ebb7f5e9ae9c1d6179e9129b705923df7830c67f3690cbc48649d4079eadf5397339580c",
+			"851f8a0b82a6d86202a61cbc3b0f3db7d19650b914587bde4715ccd372e1e40cab95517779d840416e1679c84a6db24e",
+			"99bef05aaba1ea467fcbc9c420f5e3153c9d2b5f9bf2c7e2e7f6946f854043627b45b008607b9a9108bb96f3c1c089d3",
+			"8d9e19b3f4c7c233a6112e5397309f9812a4f61f754f11dd3dcb8b07d55a7b1dfea65f19a1488a14fef9a41495083582",
+		},
+		name:       "BLS12381G1",
+		h2c:        "BLS12381G1_XMD:SHA-256_SSWU_RO_",
+		e2c:        "BLS12381G1_XMD:SHA-256_SSWU_NU_",
+		basePoint:  "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+		basePointX: "17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb",
+		identity:   "c00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		fieldOrder: "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559787",
+		groupOrder: "73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001",
+		hashToCurve: testHashToCurve{
+			input:        testHashToGroupInput,
+			dst:          testHashToGroupDST,
+			hashToScalar: "4cee8fbdf61e496ad7bb1b39dca413e73cbb71accfaea261b92f21bddde4095b",
+			hashToGroup:  "8f7683ca3216dd4355727bde7e9c89afc1e0dd03b325b8a18bc3f5732d1ecb7cef7e709eb74c460613836834712fc4ef",
+		},
+		elementLength: 48,
+		scalarLength:  32,
+		group:         10,
+		hash:          crypto.SHA256,
+	},
+	{
+		multBase: [15]string{
+			"00000000ed302d991bf94c09fc98462200000000000000000000000000000040",
+			"030000b067c50313fcac1144eee2fe0e0000000000000000000000000000001c",
+			"63d232eb3b8af0b75cfcf55ade47f6ff4cdf4e47a7454cb8ed67a9ba6f56e788",
+			"fc86bc8efbbcb878f49427618b6940409b9157e3d777a4c4c0514a8e0d92db18",
+			"d10e70fdf461fb465db10c602adbd7b3fd9fdb0d492d1ecd4cbdffedecaa0ab3",
+			"eb24c6f3d47de736844b67db8f8d3c439fb95c20fb81a91ff0e13ab291630705",
+			"998b9d02ab10540a55a6ec55855c743ee3d8f8b10232bc22cc00abb11438a499",
+			"07ef940d7798553b338b80e8de384cb8b3b6860627530de8c043716fb0ec5d34",
+			"791b2c704a9b71222d23f6992b501fbdce116b05159a325706aec7b17ad2ce8c",
+			"406dd76c6e8e283e2cc28d875a16a525d549807b7bce53fdbbad3784caa8e328",
+			"290f6caca9eb73b577e38c8254e04d69413e6add5edc62475e286d1ae56083b1",
+			"62afa8924c923afd7aca5d1da63a1d471117e2acf0bc943ca790c5e896a56f88",
+			"d59baa3d7884e4c8924cd7b148f2473f66d4ede6981c16a520288229211ef427",
+			"160fcab87611b68c514ceaf51bd746f6902e8a3ba7f963c2400a04ed883fe914",
+			"fc53e467ff87d63f4bb1e8e30c7ace47da8b73b835d4ae704f7f6592ff372d9e",
+		},
+		name:       "Pallas",
+		h2c:        "pallas_XMD:SHA-256_SSWU_RO_",
+		e2c:        "pallas_XMD:SHA-256_SSWU_NU_",
+		basePoint:  "00000000ed302d991bf94c09fc98462200000000000000000000000000000040",
+		basePointX: "00000000ed302d991bf94c09fc98462200000000000000000000000000000040",
+		identity:   "0000000000000000000000000000000000000000000000000000000000000000",
+		fieldOrder: "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+		groupOrder: "0100000021eb468cdda89409fc98462200000000000000000000000000000040",
+		hashToCurve: testHashToCurve{
+			input:        testHashToGroupInput,
+			dst:          testHashToGroupDST,
+			hashToScalar: "d68c58110a4d57e48c712d41e421561bbec6a517934116e84b65cb757ec54222",
+			hashToGroup:  "fe6190c8937305558f4274104b1785c2da737b182be5d67dbcd7aae3291a91b8",
+		},
+		elementLength: 32,
+		scalarLength:  32,
+		group:         11,
+		hash:          crypto.SHA256,
+	},
+	{
+		multBase: [15]string{
+			"0000000021eb468cdda89409fc98462200000000000000000000000000000040",
+			"03000070de065fede0093144eee2fe0e0000000000000000000000000000001c",
+			"5fce556feb6fee5a15560ddabae10224b026a5d0281af4c613955c39a8797837",
+			"f79037a77e26a2c0794dc326d866c664616499c064073a8f8ebf3080297be5ab",
+			"5480a31defb30ad75ba423b14da36acb46c1cff727575a2a6b5090262da5e823",
+			"fa9553dbbc34b5ca9c03f5ee975bbc66ef7fe6a16e4568779708648c406a8c13",
+			"d9b64d40adcf7b8c3155141bc2e813c9c83d49cc66c199856d118b9530ebccb7",
+			"ab2cecbc329b95461e3993c4ddb07d5132cdafea622f88869dd6af129fce1517",
+			"7acaf6dfb451dbec3b23b191c0418c0dff2aef2717968f45d0687420aa21b511",
+			"5dd951afd934da1f383baff361d8acc11bea9c7e6027a4e0c3fe2eb45d00dc1e",
+			"b53c00cb3ad36a244109dba361ad572f9ae63b80f98f002c77496ee9424be033",
+			"77bc9a12b4797c2c2c3f5303c3612a72bbb9f8bf80b0948c0d71d8ebc66d9816",
+			"9b8b53cf73dc3db2a1497a3c6c3a10dcf21da5b2304d09ad7c35898d7c5c7416",
+			"6109000113b6af36584d487be189924e226fefe86abec647b31b6919fbe64296",
+			"3104ccbce28d9ece80b0c63794e39e2a3d7b535862976bba7adedef8f985862e",
+		},
+		name:       "Vesta",
+		h2c:        "vesta_XMD:SHA-256_SSWU_RO_",
+		e2c:        "vesta_XMD:SHA-256_SSWU_NU_",
+		basePoint:  "0000000021eb468cdda89409fc98462200000000000000000000000000000040",
+		basePointX: "0000000021eb468cdda89409fc98462200000000000000000000000000000040",
+		identity:   "0000000000000000000000000000000000000000000000000000000000000000",
+		fieldOrder: "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+		groupOrder: "01000000ed302d991bf94c09fc98462200000000000000000000000000000040",
+		hashToCurve: testHashToCurve{
+			input:        testHashToGroupInput,
+			dst:          testHashToGroupDST,
+			hashToScalar: "d68c58111e1c5cb9220ed51529e3e9c488cb370a633bff4793fad8757ec54222",
+			hashToGroup:  "548f130d310128702676193cdf69aaa5490df5bc9c589d272254f4e95677ec0c",
+		},
+		elementLength: 32,
+		scalarLength:  32,
+		group:         12,
+		hash:          crypto.SHA256,
+	},
 }
@@ -18,10 +18,12 @@ import (
 )
 
 var (
-	errNoPanic        = errors.New("no panic")
-	errNoPanicMessage = errors.New("panic but no message")
-	errZeroLenDST     = errors.New("zero-length DST")
-	errWrapGroup      = "%s: %w"
+	errNoPanic          = errors.New("no panic")
+	errNoPanicMessage   = errors.New("panic but no message")
+	errZeroLenDST       = errors.New("zero-length DST")
+	errNonPositiveCount = errors.New("count must be positive")
+	errNoShares         = errors.New("no shares to combine")
+	errWrapGroup        = "%s: %w"
 )
 
 // hasPanic runs f and recovers from a panic if any occurred, and returns whether it did and the panic message as an
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+)
+
+func randomScalarVector(t *testing.T, g ecc.Group, n int) *ecc.ScalarVector {
+	scalars := make([]*ecc.Scalar, n)
+	for i := range scalars {
+		scalars[i] = g.NewScalar().Random()
+	}
+
+	v, err := g.NewScalarVector(scalars...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func TestScalarVector_Arithmetic(t *testing.T) {
+	const n = 5
+
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		v, w := randomScalarVector(t, g, n), randomScalarVector(t, g, n)
+		s := g.NewScalar().Random()
+
+		for _, test := range []struct {
+			name string
+			op   func(v *ecc.ScalarVector) error
+			f    func(i int) *ecc.Scalar
+		}{
+			{"Add", func(v *ecc.ScalarVector) error { return v.Add(w) },
+				func(i int) *ecc.Scalar { return v.Get(i).Copy().Add(w.Get(i)) }},
+			{"Sub", func(v *ecc.ScalarVector) error { return v.Sub(w) },
+				func(i int) *ecc.Scalar { return v.Get(i).Copy().Subtract(w.Get(i)) }},
+			{"Hadamard", func(v *ecc.ScalarVector) error { return v.Hadamard(w) },
+				func(i int) *ecc.Scalar { return v.Get(i).Copy().Multiply(w.Get(i)) }},
+			{"ScalarMul", func(v *ecc.ScalarVector) error { return v.ScalarMul(s) },
+				func(i int) *ecc.Scalar { return v.Get(i).Copy().Multiply(s) }},
+			{"BatchInvert", func(v *ecc.ScalarVector) error { return v.BatchInvert() },
+				func(i int) *ecc.Scalar { return v.Get(i).Copy().Invert() }},
+		} {
+			res := v.Copy()
+			if err := test.op(res); err != nil {
+				t.Fatalf("%s: %v", test.name, err)
+			}
+
+			for i := range n {
+				if !res.Get(i).Equal(test.f(i)) {
+					t.Fatalf("%s: %s", test.name, errExpectedEquality)
+				}
+			}
+		}
+
+		expected := g.NewScalar()
+		for i := range n {
+			expected.Add(v.Get(i).Copy().Multiply(w.Get(i)))
+		}
+
+		ip, err := v.InnerProduct(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !ip.Equal(expected) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
+
+func TestScalarVector_Errors(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		v := randomScalarVector(t, g, 3)
+		original := v.Copy()
+		short := randomScalarVector(t, g, 2)
+
+		var wrongGroup ecc.Group = ecc.Ristretto255Sha512
+		if g == ecc.Ristretto255Sha512 {
+			wrongGroup = ecc.P256Sha256
+		}
+
+		other := randomScalarVector(t, wrongGroup, 3)
+
+		for _, op := range []func(*ecc.ScalarVector) error{v.Add, v.Sub, v.Hadamard} {
+			if err := op(short); !errors.Is(err, ecc.ErrVectorLength) {
+				t.Fatalf("expected length error, got %v", err)
+			}
+
+			if err := op(other); !errors.Is(err, ecc.ErrVectorGroup) {
+				t.Fatalf("expected group error, got %v", err)
+			}
+		}
+
+		if _, err := v.InnerProduct(short); !errors.Is(err, ecc.ErrVectorLength) {
+			t.Fatalf("expected length error, got %v", err)
+		}
+
+		if _, err := v.InnerProduct(other); !errors.Is(err, ecc.ErrVectorGroup) {
+			t.Fatalf("expected group error, got %v", err)
+		}
+
+		if err := v.ScalarMul(wrongGroup.NewScalar().Random()); !errors.Is(err, ecc.ErrVectorGroup) {
+			t.Fatalf("expected group error, got %v", err)
+		}
+
+		if err := v.ScalarMul(nil); err == nil {
+			t.Fatal("expected error on nil scalar")
+		}
+
+		if _, err := g.NewScalarVector(g.NewScalar(), wrongGroup.NewScalar()); !errors.Is(err, ecc.ErrVectorGroup) {
+			t.Fatalf("expected group error, got %v", err)
+		}
+
+		if _, err := g.NewScalarVector(g.NewScalar(), nil); err == nil {
+			t.Fatal("expected error on nil scalar")
+		}
+
+		withZero := v.Copy()
+		withZero.Get(1).Zero()
+		snapshot := withZero.Copy()
+
+		if err := withZero.BatchInvert(); !errors.Is(err, ecc.ErrVectorZero) {
+			t.Fatalf("expected zero error, got %v", err)
+		}
+
+		if !withZero.Equal(snapshot) || !v.Equal(original) {
+			t.Fatal("vector must not be modified on error")
+		}
+	})
+}
+
+func TestScalarVector_Encoding(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		v := randomScalarVector(t, g, 4)
+		encoded := v.Encode()
+
+		if len(encoded) != 4*g.ScalarLength() {
+			t.Fatal("unexpected encoding length")
+		}
+
+		decoded := g.NewZeroScalarVector(0)
+		if err := decoded.Decode(encoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if !decoded.Equal(v) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if err := decoded.Decode(encoded[1:]); !errors.Is(err, ecc.ErrVectorLength) {
+			t.Fatalf("expected length error, got %v", err)
+		}
+
+		// An invalid scalar encoding must fail and leave the vector untouched.
+		bad := append([]byte{}, encoded...)
+		copy(bad[g.ScalarLength():], g.Order())
+		if err := decoded.Decode(bad); err == nil {
+			t.Fatal("expected error on invalid scalar encoding")
+		}
+
+		if !decoded.Equal(v) {
+			t.Fatal("vector must not be modified on error")
+		}
+
+		empty := g.NewZeroScalarVector(0)
+		if err := empty.Decode(empty.Encode()); err != nil || empty.Len() != 0 {
+			t.Fatal("expected empty vector round trip")
+		}
+	})
+}
@@ -0,0 +1,187 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/0xBridge/ecc"
+	"github.com/0xBridge/ecc/internal"
+)
+
+// BIP340 test vectors: secret keys and their x-only public keys.
+var bip340Keys = []struct {
+	secretKey string
+	publicKey string
+}{
+	{
+		secretKey: "0000000000000000000000000000000000000000000000000000000000000003",
+		publicKey: "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+	},
+	{
+		secretKey: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+		publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+	},
+	{
+		secretKey: "C90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74020BBEA63B14E5C9",
+		publicKey: "DD308AFEC5777E13121FA72B9CC1B7CC0139715309B086C960E18FD969774EB8",
+	},
+	{
+		secretKey: "0B432B2677937381AEF05BB02A66ECD012773062CF3FA2549E44F58ED2401710",
+		publicKey: "25D1DFF95105F5253C4022F628A996AD3A0D95FBF21D468A1B33F8C160D8F517",
+	},
+}
+
+// BIP341 wallet test vectors: internal keys, their TapTweak without script tree, and the output keys.
+var bip341Keys = []struct {
+	internal string
+	tweak    string
+	output   string
+}{
+	{
+		internal: "d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d",
+		tweak:    "b86e7be8f39bab32a6f2c0443abbc210f0edac0e2c53d501b36b64437d9c6c70",
+		output:   "53a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343",
+	},
+}
+
+func TestXOnly_BIP340(t *testing.T) {
+	g := ecc.Secp256k1Sha256
+
+	for _, v := range bip340Keys {
+		sk := decodeScalar(t, g, strings.ToLower(v.secretKey))
+		pk := g.Base().Multiply(sk)
+
+		if got := strings.ToUpper(hex.EncodeToString(pk.EncodeXOnly())); got != v.publicKey {
+			t.Fatalf("unexpected x-only public key %s", got)
+		}
+
+		x, err := hex.DecodeString(v.publicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lifted, err := ecc.DecodeXOnly(g, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The lifted element has an even y, and is either the public key or its negation.
+		if y := lifted.YCoordinate(); y[len(y)-1]&1 != 0 {
+			t.Fatal("expected an even y coordinate")
+		}
+
+		if !lifted.Equal(pk) && !lifted.Equal(pk.Copy().Negate()) {
+			t.Fatal("unexpected lifted element")
+		}
+
+		if !bytes.Equal(lifted.EncodeXOnly(), x) || !bytes.Equal(pk.Copy().Negate().EncodeXOnly(), x) {
+			t.Fatal("expected the same x-only encoding")
+		}
+	}
+}
+
+func TestXOnly_Decode_Fails(t *testing.T) {
+	g := ecc.Secp256k1Sha256
+
+	for _, x := range []string{
+		// BIP340 test vector 5: not the x coordinate of a point.
+		"EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34",
+		// BIP340 test vector 14: exceeds the field size.
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC30",
+	} {
+		b, err := hex.DecodeString(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = ecc.DecodeXOnly(g, b); err == nil {
+			t.Fatalf("expected error decoding %s", x)
+		}
+	}
+
+	if _, err := ecc.DecodeXOnly(g, make([]byte, 33)); !errors.Is(err, internal.ErrDecodingInvalidLength) {
+		t.Fatalf("expected invalid length error, got %v", err)
+	}
+
+	testAllGroups(t, func(group *testGroup) {
+		if group.group == g {
+			return
+		}
+
+		if _, err := ecc.DecodeXOnly(group.group, make([]byte, 32)); !errors.Is(err, errors.ErrUnsupported) {
+			t.Fatalf("expected unsupported error, got %v", err)
+		}
+
+		if group.group.Base().EncodeXOnly() != nil {
+			t.Fatal("expected nil x-only encoding")
+		}
+	})
+
+	if g.NewElement().EncodeXOnly() != nil {
+		t.Fatal("expected nil x-only encoding of the identity")
+	}
+}
+
+// tapTweak returns the BIP341 TapTweak tagged hash of the x-only internal key without script tree.
+func tapTweak(internalKey []byte) []byte {
+	tag := sha256.Sum256([]byte("TapTweak"))
+	h := sha256.New()
+	_, _ = h.Write(tag[:])
+	_, _ = h.Write(tag[:])
+	_, _ = h.Write(internalKey)
+
+	return h.Sum(nil)
+}
+
+func TestXOnly_TweakAdd_BIP341(t *testing.T) {
+	g := ecc.Secp256k1Sha256
+
+	for _, v := range bip341Keys {
+		internalKey, err := hex.DecodeString(v.internal)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if tweak := hex.EncodeToString(tapTweak(internalKey)); tweak != v.tweak {
+			t.Fatalf("unexpected tweak %s", tweak)
+		}
+
+		p, err := ecc.DecodeXOnly(g, internalKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if output := hex.EncodeToString(p.TweakAdd(decodeScalar(t, g, v.tweak)).EncodeXOnly()); output != v.output {
+			t.Fatalf("unexpected output key %s", output)
+		}
+	}
+}
+
+func TestElement_TweakAdd(t *testing.T) {
+	testAllGroups(t, func(group *testGroup) {
+		g := group.group
+		sk := g.NewScalar().Random()
+		tweak := g.NewScalar().Random()
+
+		// (sk + t) * G = sk * G + t * G
+		if !g.ScalarBaseMult(sk).TweakAdd(tweak).Equal(g.ScalarBaseMult(sk.Copy().Add(tweak))) {
+			t.Fatal(errExpectedEquality)
+		}
+
+		if !g.Base().TweakAdd(nil).Equal(g.Base()) {
+			t.Fatal(errExpectedEquality)
+		}
+	})
+}
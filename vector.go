@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+var (
+	// ErrVectorLength indicates that two vectors, or a vector and its encoding, have incompatible lengths.
+	ErrVectorLength = errors.New("vector length mismatch")
+
+	// ErrVectorGroup indicates that vectors or scalars of different groups have been combined.
+	ErrVectorGroup = errors.New("vector group mismatch")
+
+	// ErrVectorZero indicates that a vector holds a zero scalar where an invertible one is required.
+	ErrVectorZero = errors.New("vector holds a zero scalar")
+)
+
+// ScalarVector is a vector of scalars of the same group, with batch operations. The arithmetic methods mutate the
+// receiver, like those of Scalar, and return an error without modifying the receiver if the operands are incompatible.
+type ScalarVector struct {
+	scalars []*Scalar
+	group   Group
+}
+
+// NewScalarVector returns a new vector of the group holding copies of the given scalars. It returns an error if any
+// scalar is nil or belongs to another group.
+func (g Group) NewScalarVector(scalars ...*Scalar) (*ScalarVector, error) {
+	v := &ScalarVector{
+		scalars: make([]*Scalar, len(scalars)),
+		group:   g,
+	}
+
+	for i, s := range scalars {
+		if s == nil {
+			return nil, fmt.Errorf("scalar vector: %w", internal.ErrParamNilScalar)
+		}
+
+		if s.Group() != g {
+			return nil, ErrVectorGroup
+		}
+
+		v.scalars[i] = s.Copy()
+	}
+
+	return v, nil
+}
+
+// NewZeroScalarVector returns a new vector of the group of length n, with all scalars set to 0.
+func (g Group) NewZeroScalarVector(n int) *ScalarVector {
+	v := &ScalarVector{
+		scalars: make([]*Scalar, n),
+		group:   g,
+	}
+
+	for i := range v.scalars {
+		v.scalars[i] = g.NewScalar()
+	}
+
+	return v
+}
+
+// Group returns the group's Identifier.
+func (v *ScalarVector) Group() Group {
+	return v.group
+}
+
+// Len returns the number of scalars in v.
+func (v *ScalarVector) Len() int {
+	return len(v.scalars)
+}
+
+// Get returns the i-th scalar of v. The returned scalar is not a copy, and modifying it modifies v.
+func (v *ScalarVector) Get(i int) *Scalar {
+	return v.scalars[i]
+}
+
+// Copy returns a deep copy of v.
+func (v *ScalarVector) Copy() *ScalarVector {
+	c := &ScalarVector{
+		scalars: make([]*Scalar, len(v.scalars)),
+		group:   v.group,
+	}
+
+	for i, s := range v.scalars {
+		c.scalars[i] = s.Copy()
+	}
+
+	return c
+}
+
+// Equal returns whether v and w hold the same scalars in the same order.
+func (v *ScalarVector) Equal(w *ScalarVector) bool {
+	if w == nil || v.group != w.group || len(v.scalars) != len(w.scalars) {
+		return false
+	}
+
+	equal := true
+	for i, s := range v.scalars {
+		equal = s.Equal(w.scalars[i]) && equal
+	}
+
+	return equal
+}
+
+func (v *ScalarVector) compatible(w *ScalarVector) error {
+	if w == nil || v.group != w.group {
+		return ErrVectorGroup
+	}
+
+	if len(v.scalars) != len(w.scalars) {
+		return ErrVectorLength
+	}
+
+	return nil
+}
+
+// Add sets v to the element-wise sum of v and w.
+func (v *ScalarVector) Add(w *ScalarVector) error {
+	if err := v.compatible(w); err != nil {
+		return err
+	}
+
+	for i, s := range v.scalars {
+		s.Add(w.scalars[i])
+	}
+
+	return nil
+}
+
+// Sub sets v to the element-wise difference of v and w.
+func (v *ScalarVector) Sub(w *ScalarVector) error {
+	if err := v.compatible(w); err != nil {
+		return err
+	}
+
+	for i, s := range v.scalars {
+		s.Subtract(w.scalars[i])
+	}
+
+	return nil
+}
+
+// Hadamard sets v to the element-wise product of v and w.
+func (v *ScalarVector) Hadamard(w *ScalarVector) error {
+	if err := v.compatible(w); err != nil {
+		return err
+	}
+
+	for i, s := range v.scalars {
+		s.Multiply(w.scalars[i])
+	}
+
+	return nil
+}
+
+// ScalarMul multiplies every scalar of v by s.
+func (v *ScalarVector) ScalarMul(s *Scalar) error {
+	if s == nil {
+		return fmt.Errorf("scalar vector: %w", internal.ErrParamNilScalar)
+	}
+
+	if s.Group() != v.group {
+		return ErrVectorGroup
+	}
+
+	for _, e := range v.scalars {
+		e.Multiply(s)
+	}
+
+	return nil
+}
+
+// InnerProduct returns a new scalar set to the sum of the element-wise products of v and w.
+func (v *ScalarVector) InnerProduct(w *ScalarVector) (*Scalar, error) {
+	if err := v.compatible(w); err != nil {
+		return nil, err
+	}
+
+	result := v.group.NewScalar()
+	for i, s := range v.scalars {
+		result.Add(s.Copy().Multiply(w.scalars[i]))
+	}
+
+	return result, nil
+}
+
+// BatchInvert sets every scalar of v to its inverse, using a single inversion (Montgomery's trick). It returns
+// ErrVectorZero without modifying v if any scalar is 0.
+func (v *ScalarVector) BatchInvert() error {
+	if len(v.scalars) == 0 {
+		return nil
+	}
+
+	// prefix[i] = v[0] * ... * v[i-1]
+	prefix := make([]*Scalar, len(v.scalars))
+	acc := v.group.NewScalar().One()
+
+	for i, s := range v.scalars {
+		if s.IsZero() {
+			return ErrVectorZero
+		}
+
+		prefix[i] = acc.Copy()
+		acc.Multiply(s)
+	}
+
+	acc.Invert()
+
+	for i := len(v.scalars) - 1; i >= 0; i-- {
+		s := v.scalars[i]
+		inv := acc.Copy().Multiply(prefix[i])
+		acc.Multiply(s)
+		s.Set(inv)
+	}
+
+	return nil
+}
+
+// Encode returns the concatenation of the encodings of the scalars of v.
+func (v *ScalarVector) Encode() []byte {
+	if len(v.scalars) == 0 {
+		return []byte{}
+	}
+
+	out := make([]byte, 0, len(v.scalars)*v.group.ScalarLength())
+	for _, s := range v.scalars {
+		out = append(out, s.Encode()...)
+	}
+
+	return out
+}
+
+// Decode sets v to the decoding of the concatenated scalar encodings in data, as returned by Encode, keeping the group of
+// v. The length of data must be a multiple of the group's scalar length. On error, v is left untouched.
+func (v *ScalarVector) Decode(data []byte) error {
+	if !v.group.Available() {
+		return fmt.Errorf("scalar vector Decode: %w", internal.ErrInvalidGroup)
+	}
+
+	length := v.group.ScalarLength()
+	if len(data)%length != 0 {
+		return fmt.Errorf("scalar vector Decode: %w", ErrVectorLength)
+	}
+
+	scalars := make([]*Scalar, len(data)/length)
+	for i := range scalars {
+		scalars[i] = v.group.NewScalar()
+		if err := scalars[i].Decode(data[i*length : (i+1)*length]); err != nil {
+			return fmt.Errorf("scalar vector Decode: scalar %d: %w", i, err)
+		}
+	}
+
+	v.scalars = scalars
+
+	return nil
+}
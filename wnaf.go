@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import "github.com/0xBridge/ecc/internal"
+
+// wnafWindow is the window width, in bits, of the width-w non-adjacent form used in variable-time multiplication.
+const wnafWindow = 5
+
+// littleEndianScalar returns the little-endian encoding of the scalar.
+func (g Group) littleEndianScalar(s internal.Scalar) []byte {
+	encoded := s.Encode()
+	if !g.littleEndianScalars() {
+		encoded = internal.Reverse(encoded)
+	}
+
+	return encoded
+}
+
+// wnaf returns the width-w non-adjacent form of the little-endian encoded scalar, i.e. digits that are either 0 or odd
+// and strictly between -2^(w-1) and 2^(w-1), such that scalar = sum(digits[i] * 2^i) and any w consecutive digits hold
+// at most one non-zero digit.
+func wnaf(scalar []byte, bitLen, w int) []int {
+	digits := make([]int, bitLen+1)
+	width := 1 << w
+	carry := 0
+
+	for pos := 0; pos < len(digits); {
+		window := carry + scalarDigit(scalar, pos, w)
+		if window&1 == 0 {
+			pos++
+			continue
+		}
+
+		if window < width/2 {
+			carry = 0
+			digits[pos] = window
+		} else {
+			carry = 1
+			digits[pos] = window - width
+		}
+
+		pos += w
+	}
+
+	return digits
+}
+
+// wnafTables returns the odd multiples table[i] = (2i + 1) * element and their negations negTable[i] = -table[i], for
+// 0 <= i < 2^(wnafWindow-2). The negated multiples are built from a single negation, since some backends negate through
+// the element's encoding.
+func wnafTables(element internal.Element) (table, negTable []*Element) {
+	table = make([]*Element, 1<<(wnafWindow-2))
+	negTable = make([]*Element, len(table))
+	table[0] = newPoint(element.Copy())
+	negTable[0] = table[0].Copy().Negate()
+	double, negDouble := table[0].Copy().Double(), negTable[0].Copy().Double()
+
+	for i := 1; i < len(table); i++ {
+		table[i] = table[i-1].Copy().Add(double)
+		negTable[i] = negTable[i-1].Copy().Add(negDouble)
+	}
+
+	return table, negTable
+}
+
+// wnafMult returns the sum of the products of the little-endian encoded scalars and the elements, in variable time, by
+// adding precomputed odd multiples of the elements, or their negations, indexed by the scalars' width-w non-adjacent
+// form digits. The doublings are shared across all terms, which are interleaved.
+func (g Group) wnafMult(scalars [][]byte, elements []internal.Element) *Element {
+	tables := make([][]*Element, len(elements))
+	negTables := make([][]*Element, len(elements))
+	digits := make([][]int, len(scalars))
+
+	for i, e := range elements {
+		tables[i], negTables[i] = wnafTables(e)
+		digits[i] = wnaf(scalars[i], g.scalarBitLen(), wnafWindow)
+	}
+
+	result := g.NewElement()
+	started := false
+
+	for pos := g.scalarBitLen(); pos >= 0; pos-- {
+		if started {
+			result.Double()
+		}
+
+		for i := range digits {
+			switch d := digits[i][pos]; {
+			case d > 0:
+				result.Add(tables[i][d/2])
+				started = true
+			case d < 0:
+				result.Add(negTables[i][-d/2])
+				started = true
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+//
+// Copyright (C) 2020-2024 Daniel Bourdrez. All Rights Reserved.
+//
+// This source code is licensed under the MIT license found in the
+// LICENSE file in the root directory of this source tree or at
+// https://spdx.org/licenses/MIT.html
+
+package ecc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xBridge/ecc/internal"
+)
+
+// xOnlyLength is the byte length of BIP340 x-only public keys.
+const xOnlyLength = 32
+
+// EncodeXOnly returns the 32-byte x coordinate of the element, as BIP340 encodes public keys for Secp256k1Sha256, with
+// an implicit even y coordinate. The elements P and -P have the same encoding. It returns nil for the identity and for
+// the other groups.
+func (e *Element) EncodeXOnly() []byte {
+	if e.Group() != Secp256k1Sha256 || e.IsIdentity() {
+		return nil
+	}
+
+	return e.Element.Encode()[1:]
+}
+
+// DecodeXOnly returns the element of the group with the 32-byte BIP340 x-only encoding and an even y coordinate, i.e.
+// the lift_x function of BIP340. An error is returned if the encoding is not the x coordinate of a point, and for the
+// groups other than Secp256k1Sha256.
+func DecodeXOnly(g Group, x []byte) (*Element, error) {
+	if g != Secp256k1Sha256 {
+		return nil, fmt.Errorf("DecodeXOnly: %w", errors.ErrUnsupported)
+	}
+
+	if len(x) != xOnlyLength {
+		return nil, fmt.Errorf("DecodeXOnly: %w", internal.ErrDecodingInvalidLength)
+	}
+
+	e := g.NewElement()
+	if err := e.Element.Decode(append([]byte{0x02}, x...)); err != nil {
+		return nil, fmt.Errorf("DecodeXOnly: %w", err)
+	}
+
+	return e, nil
+}
+
+// TweakAdd sets the receiver to the sum of the receiver and t * G, where G is the group's base point, and returns it,
+// e.g. to derive a BIP341 Taproot output key from the internal key lifted with DecodeXOnly and the TapTweak tagged
+// hash. A nil tweak leaves the receiver unchanged. It panics if the tweak does not belong to the receiver's group.
+func (e *Element) TweakAdd(t *Scalar) *Element {
+	if t == nil {
+		return e
+	}
+
+	return e.Add(e.Group().ScalarBaseMult(t))
+}